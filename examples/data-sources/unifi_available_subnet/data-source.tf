@@ -0,0 +1,20 @@
+# Find the first free /24 in 10.20.0.0/16
+data "unifi_available_subnet" "next" {
+  site_id       = "default"
+  supernet      = "10.20.0.0/16"
+  prefix_length = 24
+}
+
+# Create a network in the allocated subnet
+resource "unifi_network" "example" {
+  site_id    = "default"
+  name       = "Allocated Network"
+  enabled    = true
+  vlan_id    = 120
+  management = "third-party"
+
+  ipv4_configuration = {
+    host_ip_address = data.unifi_available_subnet.next.host_ip_address
+    prefix_length   = 24
+  }
+}
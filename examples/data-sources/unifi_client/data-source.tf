@@ -0,0 +1,9 @@
+# Look up a connected client by its MAC address
+data "unifi_client" "printer" {
+  site_id     = "your-site-id"
+  mac_address = "aa:bb:cc:dd:ee:ff"
+}
+
+output "printer_ip_address" {
+  value = data.unifi_client.printer.ip_address
+}
@@ -0,0 +1,8 @@
+data "unifi_dns_policy" "nas" {
+  site_id = "your-site-id"
+  id      = "your-dns-policy-id"
+}
+
+output "nas_address" {
+  value = data.unifi_dns_policy.nas.ipv4_address
+}
@@ -0,0 +1,21 @@
+# Read an existing firewall policy
+data "unifi_firewall_policy" "source" {
+  site_id = "your-site-id"
+  id      = "your-firewall-policy-id"
+}
+
+# Create a disabled copy of the policy for review
+resource "unifi_firewall_policy" "clone" {
+  site_id                 = data.unifi_firewall_policy.source.site_id
+  name                    = "${data.unifi_firewall_policy.source.name} (copy)"
+  description             = data.unifi_firewall_policy.source.description
+  enabled                 = false
+  action                  = data.unifi_firewall_policy.source.action
+  source                  = data.unifi_firewall_policy.source.source
+  destination             = data.unifi_firewall_policy.source.destination
+  ip_protocol_scope       = data.unifi_firewall_policy.source.ip_protocol_scope
+  connection_state_filter = data.unifi_firewall_policy.source.connection_state_filter
+  ipsec_filter            = data.unifi_firewall_policy.source.ipsec_filter
+  logging_enabled         = data.unifi_firewall_policy.source.logging_enabled
+  schedule                = data.unifi_firewall_policy.source.schedule
+}
@@ -0,0 +1,8 @@
+data "unifi_traffic_matching_list" "web_ports" {
+  site_id = "your-site-id"
+  id      = "your-traffic-matching-list-id"
+}
+
+output "web_ports" {
+  value = data.unifi_traffic_matching_list.web_ports.port_items
+}
@@ -0,0 +1,9 @@
+# Get details of a specific WiFi broadcast
+data "unifi_wifi_broadcast" "example" {
+  site_id = "your-site-id"
+  id      = "your-wifi-broadcast-id"
+}
+
+output "wifi_security_type" {
+  value = data.unifi_wifi_broadcast.example.security_configuration.type
+}
@@ -0,0 +1,2 @@
+# unifi_acl_rule can be imported using the site ID and the ACL rule ID, separated by a slash.
+terraform import unifi_acl_rule.example <site_id>/<acl_rule_id>
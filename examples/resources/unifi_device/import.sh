@@ -0,0 +1,2 @@
+# unifi_device can be imported using the site ID and the device ID, separated by a slash.
+terraform import unifi_device.example <site_id>/<device_id>
@@ -0,0 +1,7 @@
+# Restart an access point whenever its SSID configuration changes
+resource "unifi_device" "office_ap" {
+  site_id = "your-site-id"
+  id      = "your-device-id"
+
+  restart_trigger = sha1(jsonencode(unifi_wifi_broadcast.office))
+}
@@ -0,0 +1,2 @@
+# unifi_dns_policy can be imported using the site ID and the DNS policy ID, separated by a slash.
+terraform import unifi_dns_policy.example <site_id>/<dns_policy_id>
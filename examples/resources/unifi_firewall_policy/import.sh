@@ -0,0 +1,2 @@
+# unifi_firewall_policy can be imported using the site ID and the firewall policy ID, separated by a slash.
+terraform import unifi_firewall_policy.example <site_id>/<firewall_policy_id>
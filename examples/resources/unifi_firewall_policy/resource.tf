@@ -110,10 +110,10 @@ resource "unifi_firewall_policy" "business_hours_only" {
   }
 
   schedule = {
-    mode             = "custom"
-    repeat_on_days   = ["monday", "tuesday", "wednesday", "thursday", "friday"]
-    time_range_start = "09:00"
-    time_range_end   = "18:00"
+    mode           = "time-range"
+    repeat_on_days = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+    start_time     = "09:00"
+    stop_time      = "18:00"
   }
 }
 
@@ -202,10 +202,10 @@ resource "unifi_firewall_policy" "complex" {
   }
 
   schedule = {
-    mode             = "custom"
-    repeat_on_days   = ["monday", "tuesday", "wednesday", "thursday", "friday"]
-    time_range_start = "08:00"
-    time_range_end   = "20:00"
+    mode           = "time-range"
+    repeat_on_days = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+    start_time     = "08:00"
+    stop_time      = "20:00"
   }
 
   connection_state_filter = {
@@ -0,0 +1,13 @@
+# Disable every policy whose name starts with "Guest" during an incident
+data "unifi_firewall_policies" "all" {
+  site_id = "your-site-id"
+}
+
+resource "unifi_firewall_policy_bulk_toggle" "guest_lockdown" {
+  site_id = "your-site-id"
+  policy_ids = [
+    for policy in data.unifi_firewall_policies.all.policies : policy.id
+    if startswith(policy.name, "Guest")
+  ]
+  enabled = false
+}
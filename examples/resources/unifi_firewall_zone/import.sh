@@ -0,0 +1,2 @@
+# unifi_firewall_zone can be imported using the site ID and the firewall zone ID, separated by a slash.
+terraform import unifi_firewall_zone.example <site_id>/<firewall_zone_id>
@@ -0,0 +1,2 @@
+# unifi_network can be imported using the site ID and the network ID, separated by a slash.
+terraform import unifi_network.example <site_id>/<network_id>
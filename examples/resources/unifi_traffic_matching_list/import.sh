@@ -0,0 +1,2 @@
+# unifi_traffic_matching_list can be imported using the site ID and the traffic matching list ID, separated by a slash.
+terraform import unifi_traffic_matching_list.example <site_id>/<traffic_matching_list_id>
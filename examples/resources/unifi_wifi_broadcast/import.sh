@@ -0,0 +1,2 @@
+# unifi_wifi_broadcast can be imported using the site ID and the WiFi broadcast ID, separated by a slash.
+terraform import unifi_wifi_broadcast.example <site_id>/<wifi_broadcast_id>
@@ -7,15 +7,17 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -50,6 +52,9 @@ type ACLRuleResourceModel struct {
 	NetworkIDFilter       types.String `tfsdk:"network_id_filter"`
 }
 
+// supportedACLProtocols lists the protocol names accepted in an ACL rule protocol filter.
+var supportedACLProtocols = []string{"all", "tcp", "udp", "tcp_udp", "icmp", "icmpv6", "igmp", "esp", "ah", "gre", "sctp"}
+
 func (r *ACLRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_acl_rule"
 }
@@ -125,6 +130,9 @@ func (r *ACLRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 						MarkdownDescription: "List of IP addresses or subnets.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(ipAddressOrCIDRValidator{}),
+						},
 					},
 					"network_ids": schema.ListAttribute{
 						MarkdownDescription: "List of network IDs.",
@@ -132,9 +140,12 @@ func (r *ACLRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 						ElementType:         types.StringType,
 					},
 					"mac_addresses": schema.ListAttribute{
-						MarkdownDescription: "List of MAC addresses.",
+						MarkdownDescription: "List of MAC addresses. Colon, hyphen and dot separators are accepted in either case; the addresses are sent to the controller in lowercase colon form.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(macAddressValidator{}),
+						},
 					},
 					"port_filter": schema.ListAttribute{
 						MarkdownDescription: "List of ports.",
@@ -159,6 +170,9 @@ func (r *ACLRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 						MarkdownDescription: "List of IP addresses or subnets.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(ipAddressOrCIDRValidator{}),
+						},
 					},
 					"network_ids": schema.ListAttribute{
 						MarkdownDescription: "List of network IDs.",
@@ -166,9 +180,12 @@ func (r *ACLRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 						ElementType:         types.StringType,
 					},
 					"mac_addresses": schema.ListAttribute{
-						MarkdownDescription: "List of MAC addresses.",
+						MarkdownDescription: "List of MAC addresses. Colon, hyphen and dot separators are accepted in either case; the addresses are sent to the controller in lowercase colon form.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(macAddressValidator{}),
+						},
 					},
 					"port_filter": schema.ListAttribute{
 						MarkdownDescription: "List of ports.",
@@ -182,9 +199,12 @@ func (r *ACLRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"protocol_filter": schema.ListAttribute{
-				MarkdownDescription: "List of protocols (tcp, udp, icmp, etc.).",
+				MarkdownDescription: "List of protocols (tcp, udp, icmp, etc.). Values are matched case-insensitively.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOfCaseInsensitive(supportedACLProtocols...)),
+				},
 			},
 			"network_id_filter": schema.StringAttribute{
 				MarkdownDescription: "Network ID filter.",
@@ -242,6 +262,13 @@ func (r *ACLRuleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		RuleID: data.ID.ValueString(),
 	})
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The ACL rule no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACL rule: %s", err))
 		return
 	}
@@ -289,7 +316,7 @@ func (r *ACLRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ACLRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
 }
 
 type ACLDeviceFilterModel struct {
@@ -395,28 +422,15 @@ func (r *ACLRuleResource) buildEndpointFilter(ctx context.Context, filterObj typ
 	result := &networktypes.ACLEndpointFilter{
 		Type: filter.Type.ValueString(),
 	}
-	if !filter.IpAddressesOrSubnets.IsNull() {
-		var ips []string
-		diags.Append(filter.IpAddressesOrSubnets.ElementsAs(ctx, &ips, false)...)
-		result.IpAddressesOrSubnets = ips
-	}
-	if !filter.NetworkIDs.IsNull() {
-		var networkIDs []string
-		diags.Append(filter.NetworkIDs.ElementsAs(ctx, &networkIDs, false)...)
-		result.NetworkIDs = networkIDs
-	}
-	if !filter.MacAddresses.IsNull() {
-		var macs []string
-		diags.Append(filter.MacAddresses.ElementsAs(ctx, &macs, false)...)
-		result.MacAddresses = macs
-	}
-	if !filter.PortFilter.IsNull() {
-		var ports []int64
-		diags.Append(filter.PortFilter.ElementsAs(ctx, &ports, false)...)
-		for _, p := range ports {
-			result.PortFilter = append(result.PortFilter, int(p))
+	result.IpAddressesOrSubnets = filterStrings(ctx, filter.IpAddressesOrSubnets, diags)
+	result.NetworkIDs = filterStrings(ctx, filter.NetworkIDs, diags)
+	for _, mac := range filterStrings(ctx, filter.MacAddresses, diags) {
+		if normalized, ok := normalizeMACAddress(mac); ok {
+			mac = normalized
 		}
+		result.MacAddresses = append(result.MacAddresses, mac)
 	}
+	result.PortFilter = filterPorts(ctx, filter.PortFilter, diags)
 	if !filter.PrefixLength.IsNull() {
 		pl := int(filter.PrefixLength.ValueInt64())
 		result.PrefixLength = &pl
@@ -454,10 +468,10 @@ func (r *ACLRuleResource) mapResponseToModel(ctx context.Context, resp *networkt
 	}
 
 	if resp.SourceFilter != nil {
-		data.SourceFilter = r.mapEndpointFilterToObject(ctx, resp.SourceFilter, diags)
+		data.SourceFilter = r.mapEndpointFilterToObject(ctx, resp.SourceFilter, data.SourceFilter, diags)
 	}
 	if resp.DestinationFilter != nil {
-		data.DestinationFilter = r.mapEndpointFilterToObject(ctx, resp.DestinationFilter, diags)
+		data.DestinationFilter = r.mapEndpointFilterToObject(ctx, resp.DestinationFilter, data.DestinationFilter, diags)
 	}
 	if len(resp.ProtocolFilter) > 0 {
 		protocols, d := types.ListValueFrom(ctx, types.StringType, resp.ProtocolFilter)
@@ -466,7 +480,10 @@ func (r *ACLRuleResource) mapResponseToModel(ctx context.Context, resp *networkt
 	}
 }
 
-func (r *ACLRuleResource) mapEndpointFilterToObject(ctx context.Context, filter *networktypes.ACLEndpointFilter, diags *diag.Diagnostics) types.Object {
+// mapEndpointFilterToObject maps an endpoint filter returned by the API. prior is the
+// filter from the plan or state; its MAC address spelling is kept when it names the
+// same addresses, so that the normalization done on write does not show up as a diff.
+func (r *ACLRuleResource) mapEndpointFilterToObject(ctx context.Context, filter *networktypes.ACLEndpointFilter, prior types.Object, diags *diag.Diagnostics) types.Object {
 	attrTypes := map[string]attr.Type{
 		"type":                    types.StringType,
 		"ip_addresses_or_subnets": types.ListType{ElemType: types.StringType},
@@ -476,40 +493,11 @@ func (r *ACLRuleResource) mapEndpointFilterToObject(ctx context.Context, filter
 		"prefix_length":           types.Int64Type,
 	}
 	attrValues := map[string]attr.Value{
-		"type": types.StringValue(filter.Type),
-	}
-
-	if len(filter.IpAddressesOrSubnets) > 0 {
-		ips, d := types.ListValueFrom(ctx, types.StringType, filter.IpAddressesOrSubnets)
-		diags.Append(d...)
-		attrValues["ip_addresses_or_subnets"] = ips
-	} else {
-		attrValues["ip_addresses_or_subnets"] = types.ListNull(types.StringType)
-	}
-	if len(filter.NetworkIDs) > 0 {
-		networkIDs, d := types.ListValueFrom(ctx, types.StringType, filter.NetworkIDs)
-		diags.Append(d...)
-		attrValues["network_ids"] = networkIDs
-	} else {
-		attrValues["network_ids"] = types.ListNull(types.StringType)
-	}
-	if len(filter.MacAddresses) > 0 {
-		macs, d := types.ListValueFrom(ctx, types.StringType, filter.MacAddresses)
-		diags.Append(d...)
-		attrValues["mac_addresses"] = macs
-	} else {
-		attrValues["mac_addresses"] = types.ListNull(types.StringType)
-	}
-	if len(filter.PortFilter) > 0 {
-		var ports []int64
-		for _, p := range filter.PortFilter {
-			ports = append(ports, int64(p))
-		}
-		portList, d := types.ListValueFrom(ctx, types.Int64Type, ports)
-		diags.Append(d...)
-		attrValues["port_filter"] = portList
-	} else {
-		attrValues["port_filter"] = types.ListNull(types.Int64Type)
+		"type":                    types.StringValue(filter.Type),
+		"ip_addresses_or_subnets": filterStringListOrNull(ctx, filter.IpAddressesOrSubnets, diags),
+		"network_ids":             filterStringListOrNull(ctx, filter.NetworkIDs, diags),
+		"mac_addresses":           filterStringListOrNull(ctx, r.priorMACAddressSpelling(ctx, prior, filter.MacAddresses), diags),
+		"port_filter":             filterPortListOrNull(ctx, filter.PortFilter, diags),
 	}
 	if filter.PrefixLength != nil {
 		attrValues["prefix_length"] = types.Int64Value(int64(*filter.PrefixLength))
@@ -521,3 +509,27 @@ func (r *ACLRuleResource) mapEndpointFilterToObject(ctx context.Context, filter
 	diags.Append(d...)
 	return obj
 }
+
+// priorMACAddressSpelling returns the MAC addresses of prior when they match actual
+// address by address once normalized, and actual otherwise.
+func (r *ACLRuleResource) priorMACAddressSpelling(ctx context.Context, prior types.Object, actual []string) []string {
+	if prior.IsNull() || prior.IsUnknown() {
+		return actual
+	}
+	var filter ACLEndpointFilterModel
+	if d := prior.As(ctx, &filter, basetypes.ObjectAsOptions{}); d.HasError() || filter.MacAddresses.IsNull() || filter.MacAddresses.IsUnknown() {
+		return actual
+	}
+	var macs []string
+	if d := filter.MacAddresses.ElementsAs(ctx, &macs, false); d.HasError() || len(macs) != len(actual) {
+		return actual
+	}
+	for i, mac := range macs {
+		want, ok := normalizeMACAddress(mac)
+		got, _ := normalizeMACAddress(actual[i])
+		if !ok || want != got {
+			return actual
+		}
+	}
+	return macs
+}
@@ -81,16 +81,19 @@ func (d *ACLRulesDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	result, err := d.client.ListACLRules(ctx, networktypes.ListACLRulesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.ACLRule], error) {
+		return d.client.ListACLRules(ctx, networktypes.ListACLRulesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACL rules: %s", err))
 		return
 	}
 
-	data.Rules = make([]ACLRuleSummary, 0, len(result.Data))
-	for _, r := range result.Data {
+	data.Rules = make([]ACLRuleSummary, 0, len(result))
+	for _, r := range result {
 		data.Rules = append(data.Rules, ACLRuleSummary{
 			ID:      types.StringValue(r.ID),
 			Name:    types.StringValue(r.Name),
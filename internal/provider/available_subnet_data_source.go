@@ -0,0 +1,256 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ datasource.DataSource = &AvailableSubnetDataSource{}
+
+func NewAvailableSubnetDataSource() datasource.DataSource {
+	return &AvailableSubnetDataSource{}
+}
+
+// AvailableSubnetDataSource finds the first subnet of a given size inside a supernet
+// that does not overlap any IPv4 subnet already used by a network on the site.
+type AvailableSubnetDataSource struct {
+	client *network.Client
+}
+
+type AvailableSubnetDataSourceModel struct {
+	SiteID       types.String `tfsdk:"site_id"`
+	Supernet     types.String `tfsdk:"supernet"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	Subnet       types.String `tfsdk:"subnet"`
+	HostIP       types.String `tfsdk:"host_ip_address"`
+	UsedSubnets  types.List   `tfsdk:"used_subnets"`
+}
+
+func (d *AvailableSubnetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_subnet"
+}
+
+func (d *AvailableSubnetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Computes the first IPv4 subnet of the requested size inside a supernet that does not overlap any subnet already used by a network on the site.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID whose networks are checked for overlaps.",
+				Required:            true,
+			},
+			"supernet": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 CIDR to allocate from (e.g. `10.0.0.0/16`).",
+				Required:            true,
+			},
+			"prefix_length": schema.Int64Attribute{
+				MarkdownDescription: "The prefix length of the subnet to allocate (e.g. `24`). Must not be shorter than the supernet prefix.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "The first free subnet in CIDR notation.",
+				Computed:            true,
+			},
+			"host_ip_address": schema.StringAttribute{
+				MarkdownDescription: "The first usable address of `subnet`, suitable for a network's `host_ip_address`.",
+				Computed:            true,
+			},
+			"used_subnets": schema.ListAttribute{
+				MarkdownDescription: "The existing IPv4 subnets on the site that were considered.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *AvailableSubnetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	d.client = clients.Network
+}
+
+func (d *AvailableSubnetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AvailableSubnetDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	supernet, err := netip.ParsePrefix(data.Supernet.ValueString())
+	if err != nil || !supernet.Addr().Is4() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("supernet"),
+			"Invalid Supernet",
+			fmt.Sprintf("Expected an IPv4 CIDR, got: %q", data.Supernet.ValueString()),
+		)
+		return
+	}
+	supernet = supernet.Masked()
+
+	prefixLength := int(data.PrefixLength.ValueInt64())
+	if prefixLength < supernet.Bits() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prefix_length"),
+			"Invalid Prefix Length",
+			fmt.Sprintf("Prefix length %d is shorter than the supernet prefix length %d.", prefixLength, supernet.Bits()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Computing available subnet", map[string]interface{}{
+		"site_id":       data.SiteID.ValueString(),
+		"supernet":      supernet.String(),
+		"prefix_length": prefixLength,
+	})
+
+	networks, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.Network], error) {
+		return d.client.ListNetworks(ctx, networktypes.ListNetworksRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networks: %s", err))
+		return
+	}
+
+	var used []netip.Prefix
+	for _, n := range networks {
+		ipv4 := n.IPv4Configuration
+		if ipv4 == nil {
+			// The list endpoint may omit the IPv4 configuration, so fall back to the details.
+			details, err := d.client.GetNetworkDetails(ctx, networktypes.GetNetworkDetailsRequest{
+				SiteID:    data.SiteID.ValueString(),
+				NetworkID: n.ID,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network %s: %s", n.ID, err))
+				return
+			}
+			ipv4 = details.IPv4Configuration
+		}
+		used = append(used, networkIPv4Subnets(ipv4)...)
+	}
+
+	usedStrings := make([]string, 0, len(used))
+	for _, p := range used {
+		usedStrings = append(usedStrings, p.String())
+	}
+	usedList, diags := types.ListValueFrom(ctx, types.StringType, usedStrings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.UsedSubnets = usedList
+
+	subnet, ok := firstAvailableSubnet(supernet, prefixLength, used)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"No Available Subnet",
+			fmt.Sprintf("Every /%d subnet in %s overlaps an existing network.", prefixLength, supernet),
+		)
+		return
+	}
+
+	data.Subnet = types.StringValue(subnet.String())
+	data.HostIP = types.StringValue(subnetHostAddr(subnet).String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// networkIPv4Subnets returns the masked IPv4 subnets configured on a network.
+func networkIPv4Subnets(ipv4 *networktypes.NetworkIPv4Configuration) []netip.Prefix {
+	if ipv4 == nil {
+		return nil
+	}
+
+	var result []netip.Prefix
+	if ipv4.HostIPAddress != "" && ipv4.PrefixLength != nil {
+		if addr, err := netip.ParseAddr(ipv4.HostIPAddress); err == nil {
+			if p, err := addr.Prefix(*ipv4.PrefixLength); err == nil {
+				result = append(result, p)
+			}
+		}
+	}
+	for _, s := range ipv4.AdditionalHostIPSubnets {
+		if p, err := netip.ParsePrefix(s); err == nil {
+			result = append(result, p.Masked())
+		}
+	}
+	return result
+}
+
+// firstAvailableSubnet walks supernet in steps of prefixLength and returns the first
+// candidate that overlaps none of used. When a candidate collides, the walk skips
+// past the end of the colliding prefix rather than testing every block inside it.
+func firstAvailableSubnet(supernet netip.Prefix, prefixLength int, used []netip.Prefix) (netip.Prefix, bool) {
+	start := uint64(ipv4ToUint32(supernet.Addr()))
+	end := start + uint64(1)<<(32-supernet.Bits())
+	size := uint64(1) << (32 - prefixLength)
+
+	for cur := start; cur+size <= end; {
+		candidate := netip.PrefixFrom(uint32ToIPv4(uint32(cur)), prefixLength)
+		next := cur + size
+
+		free := true
+		for _, p := range used {
+			if !p.Addr().Is4() || !candidate.Overlaps(p) {
+				continue
+			}
+			free = false
+			pEnd := uint64(ipv4ToUint32(p.Addr())) + uint64(1)<<(32-p.Bits())
+			if pEnd > next {
+				next = pEnd
+			}
+		}
+		if free {
+			return candidate, true
+		}
+		cur = next
+	}
+
+	return netip.Prefix{}, false
+}
+
+// subnetHostAddr returns the first usable host address of an IPv4 subnet.
+func subnetHostAddr(p netip.Prefix) netip.Addr {
+	if p.Bits() >= 31 {
+		return p.Addr()
+	}
+	return p.Addr().Next()
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func uint32ToIPv4(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ datasource.DataSource = &ClientDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ClientDataSource{}
+
+func NewClientDataSource() datasource.DataSource {
+	return &ClientDataSource{}
+}
+
+type ClientDataSource struct {
+	client *network.Client
+}
+
+type ClientDataSourceModel struct {
+	SiteID                 types.String `tfsdk:"site_id"`
+	ID                     types.String `tfsdk:"id"`
+	MacAddress             types.String `tfsdk:"mac_address"`
+	Name                   types.String `tfsdk:"name"`
+	Type                   types.String `tfsdk:"type"`
+	IPAddress              types.String `tfsdk:"ip_address"`
+	ConnectedAt            types.String `tfsdk:"connected_at"`
+	UplinkDeviceID         types.String `tfsdk:"uplink_device_id"`
+	AccessType             types.String `tfsdk:"access_type"`
+	Authorized             types.Bool   `tfsdk:"authorized"`
+	AuthorizationMethod    types.String `tfsdk:"authorization_method"`
+	AuthorizedAt           types.String `tfsdk:"authorized_at"`
+	AuthorizationExpiresAt types.String `tfsdk:"authorization_expires_at"`
+}
+
+func (d *ClientDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+func (d *ClientDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches details of a connected client, looked up by ID or MAC address.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the client. Exactly one of `id` and `mac_address` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"mac_address": schema.StringAttribute{
+				MarkdownDescription: "The MAC address of the client. Exactly one of `id` and `mac_address` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the client.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The connection type of the client (e.g. WIRED, WIRELESS).",
+				Computed:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the client.",
+				Computed:            true,
+			},
+			"connected_at": schema.StringAttribute{
+				MarkdownDescription: "When the client connected.",
+				Computed:            true,
+			},
+			"uplink_device_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the device the client is connected through.",
+				Computed:            true,
+			},
+			"access_type": schema.StringAttribute{
+				MarkdownDescription: "The access type of the client (e.g. DEFAULT, GUEST).",
+				Computed:            true,
+			},
+			"authorized": schema.BoolAttribute{
+				MarkdownDescription: "Whether a guest client is authorized. Null for non-guest clients.",
+				Computed:            true,
+			},
+			"authorization_method": schema.StringAttribute{
+				MarkdownDescription: "How the guest client was authorized.",
+				Computed:            true,
+			},
+			"authorized_at": schema.StringAttribute{
+				MarkdownDescription: "When the guest client was authorized.",
+				Computed:            true,
+			},
+			"authorization_expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the guest authorization expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ClientDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	d.client = clients.Network
+}
+
+func (d *ClientDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ClientDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.MacAddress.IsUnknown() {
+		return
+	}
+	if data.ID.IsNull() == data.MacAddress.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Client Lookup",
+			"Exactly one of id and mac_address must be set.",
+		)
+		return
+	}
+
+	if !data.MacAddress.IsNull() {
+		if _, err := net.ParseMAC(data.MacAddress.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("mac_address"),
+				"Invalid MAC Address",
+				fmt.Sprintf("Expected a valid MAC address, got: %q", data.MacAddress.ValueString()),
+			)
+		}
+	}
+}
+
+func (d *ClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClientDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := data.ID.ValueString()
+	if data.ID.IsNull() {
+		clientID = d.findClientIDByMac(ctx, data.SiteID.ValueString(), data.MacAddress.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Reading UniFi client", map[string]interface{}{
+		"site_id":   data.SiteID.ValueString(),
+		"client_id": clientID,
+	})
+
+	result, err := d.client.GetConnectedClientDetails(ctx, networktypes.GetConnectedClientDetailsRequest{
+		SiteID:   data.SiteID.ValueString(),
+		ClientID: clientID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(result.ID)
+	// A configured MAC address is kept as written, since the API may format it differently.
+	if data.MacAddress.IsNull() {
+		data.MacAddress = stringValueOrNull(result.MacAddress)
+	}
+	data.Name = types.StringValue(result.Name)
+	data.Type = types.StringValue(result.Type)
+	data.IPAddress = stringValueOrNull(result.IPAddress)
+	data.ConnectedAt = stringValueOrNull(result.ConnectedAt)
+	data.UplinkDeviceID = stringValueOrNull(result.UplinkDeviceID)
+	data.AccessType = types.StringNull()
+	data.Authorized = types.BoolNull()
+	data.AuthorizationMethod = types.StringNull()
+	data.AuthorizedAt = types.StringNull()
+	data.AuthorizationExpiresAt = types.StringNull()
+	if result.Access != nil {
+		data.AccessType = stringValueOrNull(result.Access.Type)
+		if result.Access.Authorized != nil {
+			data.Authorized = types.BoolValue(*result.Access.Authorized)
+		}
+		if auth := result.Access.Authorization; auth != nil {
+			data.AuthorizationMethod = stringValueOrNull(auth.AuthorizationMethod)
+			data.AuthorizedAt = stringValueOrNull(auth.AuthorizedAt)
+			data.AuthorizationExpiresAt = stringValueOrNull(auth.ExpiresAt)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findClientIDByMac returns the ID of the single connected client with the given MAC address.
+// MAC addresses are compared in their parsed form, so case and separators do not matter.
+func (d *ClientDataSource) findClientIDByMac(ctx context.Context, siteID, macAddress string, diags *diag.Diagnostics) string {
+	want, err := net.ParseMAC(macAddress)
+	if err != nil {
+		diags.AddAttributeError(path.Root("mac_address"), "Invalid MAC Address", fmt.Sprintf("Expected a valid MAC address, got: %q", macAddress))
+		return ""
+	}
+
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.ConnectedClientOverview], error) {
+		return d.client.ListConnectedClients(ctx, networktypes.ListConnectedClientsRequest{
+			SiteID:     siteID,
+			Pagination: page,
+		})
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read clients: %s", err))
+		return ""
+	}
+
+	var matches []string
+	for _, c := range result {
+		got, err := net.ParseMAC(c.MacAddress)
+		if err != nil || !bytes.Equal(got, want) {
+			continue
+		}
+		matches = append(matches, c.ID)
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("Client Not Found", fmt.Sprintf("No connected client with MAC address %s was found in site %s.", macAddress, siteID))
+		return ""
+	case 1:
+		return matches[0]
+	default:
+		diags.AddError("Multiple Clients Found", fmt.Sprintf("%d connected clients with MAC address %s were found in site %s; look the client up by id instead.", len(matches), macAddress, siteID))
+		return ""
+	}
+}
@@ -81,16 +81,19 @@ func (d *ClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	result, err := d.client.ListConnectedClients(ctx, networktypes.ListConnectedClientsRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.ConnectedClientOverview], error) {
+		return d.client.ListConnectedClients(ctx, networktypes.ListConnectedClientsRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read clients: %s", err))
 		return
 	}
 
-	data.Clients = make([]ClientSummaryModel, 0, len(result.Data))
-	for _, c := range result.Data {
+	data.Clients = make([]ClientSummaryModel, 0, len(result))
+	for _, c := range result {
 		data.Clients = append(data.Clients, ClientSummaryModel{
 			ID:         types.StringValue(c.ID),
 			Name:       types.StringValue(c.Name),
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -25,15 +26,30 @@ type DeviceDataSource struct {
 }
 
 type DeviceDataSourceModel struct {
-	SiteID          types.String `tfsdk:"site_id"`
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	MacAddress      types.String `tfsdk:"mac_address"`
-	IPAddress       types.String `tfsdk:"ip_address"`
-	Model           types.String `tfsdk:"model"`
-	State           types.String `tfsdk:"state"`
-	FirmwareVersion types.String `tfsdk:"firmware_version"`
-	Supported       types.Bool   `tfsdk:"supported"`
+	SiteID          types.String      `tfsdk:"site_id"`
+	ID              types.String      `tfsdk:"id"`
+	Name            types.String      `tfsdk:"name"`
+	MacAddress      types.String      `tfsdk:"mac_address"`
+	IPAddress       types.String      `tfsdk:"ip_address"`
+	Model           types.String      `tfsdk:"model"`
+	State           types.String      `tfsdk:"state"`
+	Adopted         types.Bool        `tfsdk:"adopted"`
+	Online          types.Bool        `tfsdk:"online"`
+	FirmwareVersion types.String      `tfsdk:"firmware_version"`
+	Supported       types.Bool        `tfsdk:"supported"`
+	PoECapable      types.Bool        `tfsdk:"poe_capable"`
+	Ports           []DevicePortModel `tfsdk:"ports"`
+}
+
+type DevicePortModel struct {
+	Idx          types.Int64  `tfsdk:"idx"`
+	State        types.String `tfsdk:"state"`
+	Connector    types.String `tfsdk:"connector"`
+	MaxSpeedMbps types.Int64  `tfsdk:"max_speed_mbps"`
+	SpeedMbps    types.Int64  `tfsdk:"speed_mbps"`
+	PoEEnabled   types.Bool   `tfsdk:"poe_enabled"`
+	PoEState     types.String `tfsdk:"poe_state"`
+	PoEStandard  types.String `tfsdk:"poe_standard"`
 }
 
 func (d *DeviceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,15 +60,67 @@ func (d *DeviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Fetches details of a specific device.",
 		Attributes: map[string]schema.Attribute{
-			"site_id":          schema.StringAttribute{Required: true},
-			"id":               schema.StringAttribute{Required: true},
-			"name":             schema.StringAttribute{Computed: true},
-			"mac_address":      schema.StringAttribute{Computed: true},
-			"ip_address":       schema.StringAttribute{Computed: true},
-			"model":            schema.StringAttribute{Computed: true},
-			"state":            schema.StringAttribute{Computed: true},
+			"site_id":     schema.StringAttribute{Required: true},
+			"id":          schema.StringAttribute{Required: true},
+			"name":        schema.StringAttribute{Computed: true},
+			"mac_address": schema.StringAttribute{Computed: true},
+			"ip_address":  schema.StringAttribute{Computed: true},
+			"model":       schema.StringAttribute{Computed: true},
+			"state":       schema.StringAttribute{Computed: true},
+			"adopted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the device has completed adoption. Derived from `state`.",
+				Computed:            true,
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether the device is currently online. Derived from `state`.",
+				Computed:            true,
+			},
 			"firmware_version": schema.StringAttribute{Computed: true},
 			"supported":        schema.BoolAttribute{Computed: true},
+			"poe_capable": schema.BoolAttribute{
+				MarkdownDescription: "Whether any port of the device can deliver PoE. Null when the device reports no ports. The PoE power budget is not reported by the API.",
+				Computed:            true,
+			},
+			"ports": schema.ListNestedAttribute{
+				MarkdownDescription: "Physical ports of the device. PoE attributes are null for ports without PoE. Per-port power draw is not reported by the API.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"idx": schema.Int64Attribute{
+							MarkdownDescription: "Port index.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Link state of the port.",
+							Computed:            true,
+						},
+						"connector": schema.StringAttribute{
+							MarkdownDescription: "Connector type (e.g. RJ45, SFP).",
+							Computed:            true,
+						},
+						"max_speed_mbps": schema.Int64Attribute{
+							MarkdownDescription: "Maximum supported link speed in Mbps.",
+							Computed:            true,
+						},
+						"speed_mbps": schema.Int64Attribute{
+							MarkdownDescription: "Current link speed in Mbps.",
+							Computed:            true,
+						},
+						"poe_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether PoE is enabled on the port.",
+							Computed:            true,
+						},
+						"poe_state": schema.StringAttribute{
+							MarkdownDescription: "PoE delivery state of the port.",
+							Computed:            true,
+						},
+						"poe_standard": schema.StringAttribute{
+							MarkdownDescription: "PoE standard supported by the port (e.g. 802.3at).",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -90,8 +158,52 @@ func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.IPAddress = types.StringValue(result.IPAddress)
 	data.Model = types.StringValue(result.Model)
 	data.State = types.StringValue(result.State)
+	data.Adopted = types.BoolValue(isDeviceAdopted(result.State))
+	data.Online = types.BoolValue(isDeviceOnline(result.State))
 	data.FirmwareVersion = types.StringValue(result.FirmwareVersion)
 	data.Supported = types.BoolValue(result.Supported)
 
+	data.Ports = []DevicePortModel{}
+	data.PoECapable = types.BoolNull()
+	if result.Interfaces != nil && len(result.Interfaces.Ports) > 0 {
+		data.PoECapable = types.BoolValue(false)
+	}
+	if result.Interfaces != nil {
+		for _, port := range result.Interfaces.Ports {
+			portModel := DevicePortModel{
+				Idx:          types.Int64Value(int64(port.Idx)),
+				State:        types.StringValue(port.State),
+				Connector:    types.StringValue(port.Connector),
+				MaxSpeedMbps: types.Int64Value(int64(port.MaxSpeedMbps)),
+				SpeedMbps:    types.Int64Value(int64(port.SpeedMbps)),
+				PoEEnabled:   types.BoolNull(),
+				PoEState:     types.StringNull(),
+				PoEStandard:  types.StringNull(),
+			}
+			if port.PoE != nil {
+				data.PoECapable = types.BoolValue(true)
+				portModel.PoEEnabled = types.BoolValue(port.PoE.Enabled)
+				portModel.PoEState = types.StringValue(port.PoE.State)
+				portModel.PoEStandard = types.StringValue(port.PoE.Standard)
+			}
+			data.Ports = append(data.Ports, portModel)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// isDeviceAdopted reports whether a device state indicates that adoption has completed.
+func isDeviceAdopted(state string) bool {
+	switch strings.ToUpper(state) {
+	case "", "PENDING_ADOPTION", "ADOPTING":
+		return false
+	default:
+		return true
+	}
+}
+
+// isDeviceOnline reports whether a device state indicates that the device is online.
+func isDeviceOnline(state string) bool {
+	return strings.EqualFold(state, "ONLINE")
+}
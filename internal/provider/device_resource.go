@@ -0,0 +1,207 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ resource.Resource = &DeviceResource{}
+var _ resource.ResourceWithImportState = &DeviceResource{}
+
+const deviceActionRestart = "RESTART"
+
+func NewDeviceResource() resource.Resource {
+	return &DeviceResource{}
+}
+
+// DeviceResource is a minimal wrapper around an adopted device. It does not manage
+// device configuration; it only restarts the device when restart_trigger changes.
+type DeviceResource struct {
+	client *network.Client
+}
+
+type DeviceResourceModel struct {
+	SiteID          types.String `tfsdk:"site_id"`
+	ID              types.String `tfsdk:"id"`
+	RestartTrigger  types.String `tfsdk:"restart_trigger"`
+	Name            types.String `tfsdk:"name"`
+	MacAddress      types.String `tfsdk:"mac_address"`
+	Model           types.String `tfsdk:"model"`
+	State           types.String `tfsdk:"state"`
+	FirmwareVersion types.String `tfsdk:"firmware_version"`
+}
+
+func (r *DeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tracks an adopted UniFi device and restarts it on demand. The device is not adopted, configured or removed by this resource; destroying it only stops tracking the device.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID where the device is adopted.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the adopted device.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"restart_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value. Changing it restarts the device; setting it for the first time does not.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the device.",
+				Computed:            true,
+			},
+			"mac_address": schema.StringAttribute{
+				MarkdownDescription: "The MAC address of the device.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model of the device.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the device as last read.",
+				Computed:            true,
+			},
+			"firmware_version": schema.StringAttribute{
+				MarkdownDescription: "The firmware version of the device.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	r.client = clients.Network
+}
+
+func (r *DeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Tracking UniFi device", map[string]interface{}{
+		"site_id":   data.SiteID.ValueString(),
+		"device_id": data.ID.ValueString(),
+	})
+
+	if err := r.readDevice(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read device: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readDevice(ctx, &data); err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The device no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read device: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DeviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a change between two values restarts the device, so adding or removing
+	// the trigger does not cause an unexpected reboot.
+	if !state.RestartTrigger.IsNull() && !data.RestartTrigger.IsNull() && !data.RestartTrigger.Equal(state.RestartTrigger) {
+		tflog.Debug(ctx, "Restarting UniFi device", map[string]interface{}{
+			"site_id":   data.SiteID.ValueString(),
+			"device_id": data.ID.ValueString(),
+		})
+
+		err := r.client.ExecuteDeviceAction(ctx, networktypes.ExecuteDeviceActionRequest{
+			SiteID:   data.SiteID.ValueString(),
+			DeviceID: data.ID.ValueString(),
+			Action:   deviceActionRestart,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restart device: %s", err))
+			return
+		}
+	}
+
+	if err := r.readDevice(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read device: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The device itself is left adopted; removing the resource only drops it from state.
+	tflog.Debug(ctx, "Removing UniFi device from state")
+}
+
+func (r *DeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateSiteScopedID(ctx, req, resp)
+}
+
+func (r *DeviceResource) readDevice(ctx context.Context, data *DeviceResourceModel) error {
+	device, err := r.client.GetAdoptedDeviceDetails(ctx, networktypes.GetAdoptedDeviceDetailsRequest{
+		SiteID:   data.SiteID.ValueString(),
+		DeviceID: data.ID.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+
+	data.Name = types.StringValue(device.Name)
+	data.MacAddress = types.StringValue(device.MacAddress)
+	data.Model = types.StringValue(device.Model)
+	data.State = types.StringValue(device.State)
+	data.FirmwareVersion = stringValueOrNull(device.FirmwareVersion)
+	return nil
+}
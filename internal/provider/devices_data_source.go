@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -25,8 +26,10 @@ type DevicesDataSource struct {
 }
 
 type DevicesDataSourceModel struct {
-	SiteID  types.String         `tfsdk:"site_id"`
-	Devices []DeviceSummaryModel `tfsdk:"devices"`
+	SiteID      types.String         `tfsdk:"site_id"`
+	ModelFilter types.String         `tfsdk:"model_filter"`
+	StateFilter types.String         `tfsdk:"state_filter"`
+	Devices     []DeviceSummaryModel `tfsdk:"devices"`
 }
 
 type DeviceSummaryModel struct {
@@ -36,6 +39,8 @@ type DeviceSummaryModel struct {
 	IPAddress       types.String `tfsdk:"ip_address"`
 	Model           types.String `tfsdk:"model"`
 	State           types.String `tfsdk:"state"`
+	Adopted         types.Bool   `tfsdk:"adopted"`
+	Online          types.Bool   `tfsdk:"online"`
 	FirmwareVersion types.String `tfsdk:"firmware_version"`
 }
 
@@ -51,17 +56,33 @@ func (d *DevicesDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The site ID.",
 				Required:            true,
 			},
+			"model_filter": schema.StringAttribute{
+				MarkdownDescription: "Only return devices of this model. Matched case-insensitively.",
+				Optional:            true,
+			},
+			"state_filter": schema.StringAttribute{
+				MarkdownDescription: "Only return devices in this state (e.g. `online`). Matched case-insensitively.",
+				Optional:            true,
+			},
 			"devices": schema.ListNestedAttribute{
-				MarkdownDescription: "List of devices.",
+				MarkdownDescription: "List of devices matching the filters.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id":               schema.StringAttribute{Computed: true},
-						"name":             schema.StringAttribute{Computed: true},
-						"mac_address":      schema.StringAttribute{Computed: true},
-						"ip_address":       schema.StringAttribute{Computed: true},
-						"model":            schema.StringAttribute{Computed: true},
-						"state":            schema.StringAttribute{Computed: true},
+						"id":          schema.StringAttribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"mac_address": schema.StringAttribute{Computed: true},
+						"ip_address":  schema.StringAttribute{Computed: true},
+						"model":       schema.StringAttribute{Computed: true},
+						"state":       schema.StringAttribute{Computed: true},
+						"adopted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the device has completed adoption. Derived from `state`.",
+							Computed:            true,
+						},
+						"online": schema.BoolAttribute{
+							MarkdownDescription: "Whether the device is currently online. Derived from `state`.",
+							Computed:            true,
+						},
 						"firmware_version": schema.StringAttribute{Computed: true},
 					},
 				},
@@ -89,16 +110,26 @@ func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	result, err := d.client.ListAdoptedDevices(ctx, networktypes.ListAdoptedDevicesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.AdoptedDevice], error) {
+		return d.client.ListAdoptedDevices(ctx, networktypes.ListAdoptedDevicesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read devices: %s", err))
 		return
 	}
 
-	data.Devices = make([]DeviceSummaryModel, 0, len(result.Data))
-	for _, device := range result.Data {
+	data.Devices = make([]DeviceSummaryModel, 0, len(result))
+	for _, device := range result {
+		// The API has no server-side filtering, so the filters are applied to the full list.
+		if !data.ModelFilter.IsNull() && !strings.EqualFold(device.Model, data.ModelFilter.ValueString()) {
+			continue
+		}
+		if !data.StateFilter.IsNull() && !strings.EqualFold(device.State, data.StateFilter.ValueString()) {
+			continue
+		}
 		data.Devices = append(data.Devices, DeviceSummaryModel{
 			ID:              types.StringValue(device.ID),
 			Name:            types.StringValue(device.Name),
@@ -106,6 +137,8 @@ func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			IPAddress:       types.StringValue(device.IPAddress),
 			Model:           types.StringValue(device.Model),
 			State:           types.StringValue(device.State),
+			Adopted:         types.BoolValue(isDeviceAdopted(device.State)),
+			Online:          types.BoolValue(isDeviceOnline(device.State)),
 			FirmwareVersion: types.StringValue(device.FirmwareVersion),
 		})
 	}
@@ -79,16 +79,19 @@ func (d *DNSPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	result, err := d.client.ListDNSPolicies(ctx, networktypes.ListDNSPoliciesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.DNSPolicy], error) {
+		return d.client.ListDNSPolicies(ctx, networktypes.ListDNSPoliciesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS policies: %s", err))
 		return
 	}
 
-	data.Policies = make([]DNSPolicySummary, 0, len(result.Data))
-	for _, p := range result.Data {
+	data.Policies = make([]DNSPolicySummary, 0, len(result))
+	for _, p := range result {
 		data.Policies = append(data.Policies, DNSPolicySummary{
 			ID:      types.StringValue(p.ID),
 			Type:    types.StringValue(p.Type),
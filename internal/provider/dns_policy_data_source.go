@@ -0,0 +1,150 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ datasource.DataSource = &DNSPolicyDataSource{}
+
+func NewDNSPolicyDataSource() datasource.DataSource {
+	return &DNSPolicyDataSource{}
+}
+
+// DNSPolicyDataSource exposes the same attributes as the unifi_dns_policy resource.
+type DNSPolicyDataSource struct {
+	client *network.Client
+}
+
+func (d *DNSPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_policy"
+}
+
+func (d *DNSPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the full record of a specific UniFi DNS policy (local DNS record).",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the DNS policy.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The DNS record type (A, AAAA, CNAME, MX, TXT, SRV, PTR).",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy is enabled.",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+			},
+			"ipv4_address": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 address (for A records).",
+				Computed:            true,
+			},
+			"ipv6_address": schema.StringAttribute{
+				MarkdownDescription: "The IPv6 address (for AAAA records).",
+				Computed:            true,
+			},
+			"target_domain": schema.StringAttribute{
+				MarkdownDescription: "The target domain (for CNAME records).",
+				Computed:            true,
+			},
+			"mail_server_domain": schema.StringAttribute{
+				MarkdownDescription: "The mail server domain (for MX records).",
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "The priority (for MX and SRV records).",
+				Computed:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text content (for TXT records).",
+				Computed:            true,
+			},
+			"server_domain": schema.StringAttribute{
+				MarkdownDescription: "The server domain (for SRV records).",
+				Computed:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "The service name (for SRV records, e.g., _sip).",
+				Computed:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol (for SRV records, e.g., _tcp, _udp).",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port number (for SRV records).",
+				Computed:            true,
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "The weight (for SRV records).",
+				Computed:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "The IP address (for PTR records).",
+				Computed:            true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The TTL in seconds.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DNSPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	d.client = clients.Network
+}
+
+func (d *DNSPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading DNS policy", map[string]interface{}{
+		"site_id":   data.SiteID.ValueString(),
+		"policy_id": data.ID.ValueString(),
+	})
+
+	result, err := d.client.GetDNSPolicy(ctx, networktypes.GetDNSPolicyRequest{
+		SiteID:   data.SiteID.ValueString(),
+		PolicyID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS policy: %s", err))
+		return
+	}
+
+	// Reuse the resource mapping so the data source output matches the resource attributes.
+	var mapper DNSPolicyResource
+	mapper.mapResponseToModel(result, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -6,13 +6,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/murasame29/unifi-client-go/services/network"
@@ -21,6 +25,25 @@ import (
 
 var _ resource.Resource = &DNSPolicyResource{}
 var _ resource.ResourceWithImportState = &DNSPolicyResource{}
+var _ resource.ResourceWithConfigValidators = &DNSPolicyResource{}
+
+// dnsPolicyRecordAttributes are the attributes that only apply to some record types.
+var dnsPolicyRecordAttributes = []string{
+	"ipv4_address", "ipv6_address", "target_domain", "mail_server_domain", "priority",
+	"text", "server_domain", "service", "protocol", "port", "weight", "ip_address",
+}
+
+// dnsPolicyRequiredAttributes lists the record attributes each record type requires.
+// The other record attributes must not be set for that type.
+var dnsPolicyRequiredAttributes = map[string][]string{
+	"A":     {"ipv4_address"},
+	"AAAA":  {"ipv6_address"},
+	"CNAME": {"target_domain"},
+	"MX":    {"mail_server_domain", "priority"},
+	"TXT":   {"text"},
+	"SRV":   {"service", "protocol", "port", "weight", "server_domain", "priority"},
+	"PTR":   {"ip_address"},
+}
 
 func NewDNSPolicyResource() resource.Resource {
 	return &DNSPolicyResource{}
@@ -70,7 +93,7 @@ func (r *DNSPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The DNS record type (A, AAAA, CNAME, MX, TXT, SRV, PTR).",
+				MarkdownDescription: "The DNS record type (A, AAAA, CNAME, MX, TXT, SRV, PTR). Each type requires the record attributes marked for it below, and the attributes of other types must be left unset.",
 				Required:            true,
 			},
 			"enabled": schema.BoolAttribute{
@@ -86,10 +109,12 @@ func (r *DNSPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 			"ipv4_address": schema.StringAttribute{
 				MarkdownDescription: "The IPv4 address (for A records).",
 				Optional:            true,
+				Validators:          []validator.String{ipv4AddressValidator{}},
 			},
 			"ipv6_address": schema.StringAttribute{
 				MarkdownDescription: "The IPv6 address (for AAAA records).",
 				Optional:            true,
+				Validators:          []validator.String{ipv6AddressValidator{}},
 			},
 			"target_domain": schema.StringAttribute{
 				MarkdownDescription: "The target domain (for CNAME records).",
@@ -130,6 +155,7 @@ func (r *DNSPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 			"ip_address": schema.StringAttribute{
 				MarkdownDescription: "The IP address (for PTR records).",
 				Optional:            true,
+				Validators:          []validator.String{ipAddressValidator{}},
 			},
 			"ttl_seconds": schema.Int64Attribute{
 				MarkdownDescription: "The TTL in seconds.",
@@ -215,35 +241,18 @@ func (r *DNSPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		PolicyID: data.ID.ValueString(),
 	})
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The DNS policy no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS policy: %s", err))
 		return
 	}
 
-	data.Type = types.StringValue(result.Type)
-	data.Enabled = types.BoolValue(result.Enabled)
-	data.Domain = types.StringValue(result.Domain)
-	data.IPv4Address = types.StringValue(result.IPv4Address)
-	data.IPv6Address = types.StringValue(result.IPv6Address)
-	data.TargetDomain = types.StringValue(result.TargetDomain)
-	data.MailServerDomain = types.StringValue(result.MailServerDomain)
-	data.Text = types.StringValue(result.Text)
-	data.ServerDomain = types.StringValue(result.ServerDomain)
-	data.Service = types.StringValue(result.Service)
-	data.Protocol = types.StringValue(result.Protocol)
-	data.IPAddress = types.StringValue(result.IPAddress)
-
-	if result.Priority != nil {
-		data.Priority = types.Int64Value(int64(*result.Priority))
-	}
-	if result.Port != nil {
-		data.Port = types.Int64Value(int64(*result.Port))
-	}
-	if result.Weight != nil {
-		data.Weight = types.Int64Value(int64(*result.Weight))
-	}
-	if result.TTLSeconds != nil {
-		data.TTLSeconds = types.Int64Value(int64(*result.TTLSeconds))
-	}
+	r.mapResponseToModel(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -316,5 +325,114 @@ func (r *DNSPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *DNSPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
+}
+
+func (r *DNSPolicyResource) mapResponseToModel(result *networktypes.DNSPolicy, data *DNSPolicyResourceModel) {
+	data.Type = types.StringValue(result.Type)
+	data.Enabled = types.BoolValue(result.Enabled)
+	data.Domain = types.StringValue(result.Domain)
+	// Each record type only returns its own fields; the others are mapped to null.
+	data.IPv4Address = dnsRecordStringValue(result.IPv4Address, data.IPv4Address)
+	data.IPv6Address = dnsRecordStringValue(result.IPv6Address, data.IPv6Address)
+	data.TargetDomain = dnsRecordStringValue(result.TargetDomain, data.TargetDomain)
+	data.MailServerDomain = dnsRecordStringValue(result.MailServerDomain, data.MailServerDomain)
+	data.Text = dnsRecordStringValue(result.Text, data.Text)
+	data.ServerDomain = dnsRecordStringValue(result.ServerDomain, data.ServerDomain)
+	data.Service = dnsRecordStringValue(result.Service, data.Service)
+	data.Protocol = dnsRecordStringValue(result.Protocol, data.Protocol)
+	data.IPAddress = dnsRecordStringValue(result.IPAddress, data.IPAddress)
+
+	if result.Priority != nil {
+		data.Priority = types.Int64Value(int64(*result.Priority))
+	}
+	if result.Port != nil {
+		data.Port = types.Int64Value(int64(*result.Port))
+	}
+	if result.Weight != nil {
+		data.Weight = types.Int64Value(int64(*result.Weight))
+	}
+	if result.TTLSeconds != nil {
+		data.TTLSeconds = types.Int64Value(int64(*result.TTLSeconds))
+	}
+}
+
+// dnsRecordStringValue maps an empty record field returned by the API to null, unless
+// prior is an explicit empty string, so that configurations written against the old
+// empty-string read-back do not show a diff.
+func dnsRecordStringValue(value string, prior types.String) types.String {
+	if value == "" && !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return stringValueOrNull(value)
+}
+
+func (r *DNSPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		dnsPolicyRecordTypeValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = dnsPolicyRecordTypeValidator{}
+
+// dnsPolicyRecordTypeValidator checks that the record attributes set match the record type:
+// the attributes the type requires must be set and the others must not.
+type dnsPolicyRecordTypeValidator struct{}
+
+func (v dnsPolicyRecordTypeValidator) Description(ctx context.Context) string {
+	return "Checks that the record attributes set match the record type."
+}
+
+func (v dnsPolicyRecordTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return "Checks that the record attributes set match the record `type`."
+}
+
+func (v dnsPolicyRecordTypeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	recordType := data.Type.ValueString()
+	required, ok := dnsPolicyRequiredAttributes[strings.ToUpper(recordType)]
+	if !ok {
+		return
+	}
+
+	values := map[string]attr.Value{
+		"ipv4_address":       data.IPv4Address,
+		"ipv6_address":       data.IPv6Address,
+		"target_domain":      data.TargetDomain,
+		"mail_server_domain": data.MailServerDomain,
+		"priority":           data.Priority,
+		"text":               data.Text,
+		"server_domain":      data.ServerDomain,
+		"service":            data.Service,
+		"protocol":           data.Protocol,
+		"port":               data.Port,
+		"weight":             data.Weight,
+		"ip_address":         data.IPAddress,
+	}
+	for _, name := range dnsPolicyRecordAttributes {
+		isSet := !values[name].IsNull()
+		// An empty string was the only way to silence the old "" read-back of fields
+		// another record type uses, so it is not treated as set for those.
+		str, isString := values[name].(types.String)
+		isEmptyString := isString && !str.IsNull() && !str.IsUnknown() && str.ValueString() == ""
+		switch {
+		case slices.Contains(required, name) && !isSet:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing DNS Record Attribute",
+				fmt.Sprintf("%s must be set for %s records.", name, recordType),
+			)
+		case !slices.Contains(required, name) && isSet && !isEmptyString:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected DNS Record Attribute",
+				fmt.Sprintf("%s cannot be set for %s records.", name, recordType),
+			)
+		}
+	}
 }
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDNSPolicyRecordTypeValidator(t *testing.T) {
+	r := &DNSPolicyResource{}
+
+	cases := map[string]struct {
+		values     map[string]tftypes.Value
+		wantErrors int
+	}{
+		"A record": {
+			values: map[string]tftypes.Value{
+				"type":         tftypes.NewValue(tftypes.String, "A"),
+				"ipv4_address": tftypes.NewValue(tftypes.String, "192.168.1.10"),
+			},
+		},
+		"A record without an address": {
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "A"),
+			},
+			wantErrors: 1,
+		},
+		"CNAME record with an IPv4 address": {
+			values: map[string]tftypes.Value{
+				"type":         tftypes.NewValue(tftypes.String, "CNAME"),
+				"ipv4_address": tftypes.NewValue(tftypes.String, "192.168.1.10"),
+			},
+			wantErrors: 2,
+		},
+		"A record with an empty target domain": {
+			values: map[string]tftypes.Value{
+				"type":          tftypes.NewValue(tftypes.String, "A"),
+				"ipv4_address":  tftypes.NewValue(tftypes.String, "192.168.1.10"),
+				"target_domain": tftypes.NewValue(tftypes.String, ""),
+			},
+		},
+		"MX record without a priority": {
+			values: map[string]tftypes.Value{
+				"type":               tftypes.NewValue(tftypes.String, "MX"),
+				"mail_server_domain": tftypes.NewValue(tftypes.String, "mail.example.com"),
+			},
+			wantErrors: 1,
+		},
+		"unknown type": {
+			values: map[string]tftypes.Value{
+				"type":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"ipv4_address": tftypes.NewValue(tftypes.String, "192.168.1.10"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := validateResourceConfig(t, dnsPolicyRecordTypeValidator{}, testResourceConfig(t, r, tc.values))
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
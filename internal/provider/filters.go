@@ -0,0 +1,65 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The helpers below convert the address, network and port lists shared by the ACL
+// rule endpoint filters and the firewall policy traffic filters. The API shapes of
+// the two filters differ, but both treat an unset list and an empty one the same.
+
+// filterStrings returns the elements of a string list, or nil when it is null or unknown.
+func filterStrings(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var values []string
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// filterPorts returns the elements of a port number list, or nil when it is null or unknown.
+func filterPorts(ctx context.Context, list types.List, diags *diag.Diagnostics) []int {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var values []int64
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	ports := make([]int, 0, len(values))
+	for _, v := range values {
+		ports = append(ports, int(v))
+	}
+	return ports
+}
+
+// filterStringListOrNull maps strings returned by the API to a list, which is null
+// when there are none so that an unset attribute does not drift to [].
+func filterStringListOrNull(ctx context.Context, values []string, diags *diag.Diagnostics) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return list
+}
+
+// filterPortListOrNull maps port numbers returned by the API to a list, which is null
+// when there are none.
+func filterPortListOrNull(ctx context.Context, ports []int, diags *diag.Diagnostics) types.List {
+	if len(ports) == 0 {
+		return types.ListNull(types.Int64Type)
+	}
+	values := make([]int64, 0, len(ports))
+	for _, p := range ports {
+		values = append(values, int64(p))
+	}
+	list, d := types.ListValueFrom(ctx, types.Int64Type, values)
+	diags.Append(d...)
+	return list
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterStrings(t *testing.T) {
+	cases := map[string]struct {
+		list types.List
+		want []string
+	}{
+		"null":      {list: types.ListNull(types.StringType), want: nil},
+		"unknown":   {list: types.ListUnknown(types.StringType), want: nil},
+		"empty":     {list: types.ListValueMust(types.StringType, []attr.Value{}), want: []string{}},
+		"populated": {list: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}), want: []string{"a", "b"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := filterStrings(context.Background(), tc.list, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterPorts(t *testing.T) {
+	cases := map[string]struct {
+		list types.List
+		want []int
+	}{
+		"null":      {list: types.ListNull(types.Int64Type), want: nil},
+		"unknown":   {list: types.ListUnknown(types.Int64Type), want: nil},
+		"empty":     {list: types.ListValueMust(types.Int64Type, []attr.Value{}), want: []int{}},
+		"populated": {list: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Value(443)}), want: []int{80, 443}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := filterPorts(context.Background(), tc.list, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterStringListOrNull(t *testing.T) {
+	cases := map[string]struct {
+		values []string
+		want   types.List
+	}{
+		"nil":       {values: nil, want: types.ListNull(types.StringType)},
+		"empty":     {values: []string{}, want: types.ListNull(types.StringType)},
+		"populated": {values: []string{"a", "b"}, want: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := filterStringListOrNull(context.Background(), tc.values, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterPortListOrNull(t *testing.T) {
+	cases := map[string]struct {
+		ports []int
+		want  types.List
+	}{
+		"nil":       {ports: nil, want: types.ListNull(types.Int64Type)},
+		"empty":     {ports: []int{}, want: types.ListNull(types.Int64Type)},
+		"populated": {ports: []int{80, 443}, want: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(80), types.Int64Value(443)})},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := filterPortListOrNull(context.Background(), tc.ports, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
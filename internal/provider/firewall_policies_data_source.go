@@ -77,16 +77,19 @@ func (d *FirewallPoliciesDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	result, err := d.client.ListFirewallPolicies(ctx, networktypes.ListFirewallPoliciesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.FirewallPolicy], error) {
+		return d.client.ListFirewallPolicies(ctx, networktypes.ListFirewallPoliciesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policies: %s", err))
 		return
 	}
 
-	data.Policies = make([]FirewallPolicySummary, 0, len(result.Data))
-	for _, p := range result.Data {
+	data.Policies = make([]FirewallPolicySummary, 0, len(result))
+	for _, p := range result {
 		data.Policies = append(data.Policies, FirewallPolicySummary{
 			ID:      types.StringValue(p.ID),
 			Name:    types.StringValue(p.Name),
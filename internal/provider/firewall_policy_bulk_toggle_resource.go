@@ -0,0 +1,234 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ resource.Resource = &FirewallPolicyBulkToggleResource{}
+
+func NewFirewallPolicyBulkToggleResource() resource.Resource {
+	return &FirewallPolicyBulkToggleResource{}
+}
+
+// FirewallPolicyBulkToggleResource sets the enabled flag of a group of existing firewall
+// policies. The policies themselves are not created, changed otherwise or removed.
+type FirewallPolicyBulkToggleResource struct {
+	client *network.Client
+}
+
+type FirewallPolicyBulkToggleResourceModel struct {
+	SiteID    types.String `tfsdk:"site_id"`
+	PolicyIDs types.Set    `tfsdk:"policy_ids"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *FirewallPolicyBulkToggleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_policy_bulk_toggle"
+}
+
+func (r *FirewallPolicyBulkToggleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables or disables a group of existing firewall policies at once. " +
+			"Only the `enabled` flag of the policies is changed. Policies removed from `policy_ids`, or left behind when this resource is destroyed, keep their current state. " +
+			"Do not list a policy that is also managed by a `unifi_firewall_policy` resource, or the two will keep overwriting each other's `enabled` value.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"policy_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the firewall policies to toggle. Every policy must exist.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policies are enabled.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *FirewallPolicyBulkToggleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	r.client = clients.Network
+}
+
+func (r *FirewallPolicyBulkToggleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallPolicyBulkToggleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyEnabled(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPolicyBulkToggleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallPolicyBulkToggleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policyIDs []string
+	resp.Diagnostics.Append(data.PolicyIDs.ElementsAs(ctx, &policyIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleted policies are dropped and a policy toggled outside Terraform flips enabled,
+	// so that either shows up as a change in the next plan.
+	var existing []string
+	drifted := false
+	for _, policyID := range policyIDs {
+		policy, err := r.client.GetFirewallPolicy(ctx, networktypes.GetFirewallPolicyRequest{
+			SiteID:   data.SiteID.ValueString(),
+			PolicyID: policyID,
+		})
+		if err != nil {
+			if isNotFound(err) {
+				tflog.Warn(ctx, "A toggled firewall policy no longer exists, removing it from state", map[string]interface{}{
+					"policy_id": policyID,
+				})
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy %s: %s", policyID, err))
+			return
+		}
+		existing = append(existing, policyID)
+		if policy.Enabled != data.Enabled.ValueBool() {
+			drifted = true
+		}
+	}
+
+	if len(existing) == 0 {
+		tflog.Warn(ctx, "None of the toggled firewall policies exist any more, removing the toggle from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	ids, diags := types.SetValueFrom(ctx, types.StringType, existing)
+	resp.Diagnostics.Append(diags...)
+	data.PolicyIDs = ids
+	if drifted {
+		data.Enabled = types.BoolValue(!data.Enabled.ValueBool())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPolicyBulkToggleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallPolicyBulkToggleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyEnabled(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPolicyBulkToggleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The policies keep their current enabled state; removing the resource only drops it from state.
+	tflog.Debug(ctx, "Removing firewall policy bulk toggle from state")
+}
+
+// applyEnabled reads every listed policy, failing if one does not exist, and then
+// updates those whose enabled flag differs from the configured value.
+func (r *FirewallPolicyBulkToggleResource) applyEnabled(ctx context.Context, data *FirewallPolicyBulkToggleResourceModel, diags *diag.Diagnostics) {
+	var policyIDs []string
+	diags.Append(data.PolicyIDs.ElementsAs(ctx, &policyIDs, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Every policy is read before any is updated, so a missing ID fails the apply
+	// without leaving the group half toggled.
+	policies := make([]*networktypes.FirewallPolicy, 0, len(policyIDs))
+	for _, policyID := range policyIDs {
+		policy, err := r.client.GetFirewallPolicy(ctx, networktypes.GetFirewallPolicyRequest{
+			SiteID:   data.SiteID.ValueString(),
+			PolicyID: policyID,
+		})
+		if err != nil {
+			if isNotFound(err) {
+				diags.AddError("Firewall Policy Not Found", fmt.Sprintf("No firewall policy with ID %s was found in site %s.", policyID, data.SiteID.ValueString()))
+				continue
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy %s: %s", policyID, err))
+			return
+		}
+		policies = append(policies, policy)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	enabled := data.Enabled.ValueBool()
+	for i, policy := range policies {
+		policyID := policyIDs[i]
+		if policy.Enabled == enabled {
+			continue
+		}
+
+		tflog.Debug(ctx, "Toggling firewall policy", map[string]interface{}{
+			"policy_id": policyID,
+			"enabled":   enabled,
+		})
+
+		// The API has no partial update for enabled, so the policy is written back as read.
+		_, err := r.client.UpdateFirewallPolicy(ctx, networktypes.UpdateFirewallPolicyRequest{
+			SiteID:                data.SiteID.ValueString(),
+			PolicyID:              policyID,
+			Enabled:               enabled,
+			Name:                  policy.Name,
+			Description:           policy.Description,
+			Action:                policy.Action,
+			Source:                policy.Source,
+			Destination:           policy.Destination,
+			IPProtocolScope:       policy.IPProtocolScope,
+			ConnectionStateFilter: policy.ConnectionStateFilter,
+			IpsecFilter:           policy.IpsecFilter,
+			LoggingEnabled:        policy.LoggingEnabled,
+			Schedule:              policy.Schedule,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update firewall policy %s: %s", policyID, err))
+			return
+		}
+	}
+}
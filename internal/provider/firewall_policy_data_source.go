@@ -0,0 +1,322 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ datasource.DataSource = &FirewallPolicyDataSource{}
+
+func NewFirewallPolicyDataSource() datasource.DataSource {
+	return &FirewallPolicyDataSource{}
+}
+
+// FirewallPolicyDataSource exposes the same attributes as the unifi_firewall_policy
+// resource so that an existing policy can be read and used as the basis for a new one.
+type FirewallPolicyDataSource struct {
+	client *network.Client
+}
+
+func (d *FirewallPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_policy"
+}
+
+func (d *FirewallPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the full configuration of a specific firewall policy.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the firewall policy.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the firewall policy.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy is enabled.",
+				Computed:            true,
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "The evaluation position of the policy, as assigned by the controller.",
+				Computed:            true,
+			},
+			"action": schema.SingleNestedAttribute{
+				MarkdownDescription: "The action configuration.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Action type (allow, drop, reject).",
+						Computed:            true,
+					},
+					"allow_return_traffic": schema.BoolAttribute{
+						MarkdownDescription: "Whether to allow return traffic.",
+						Computed:            true,
+					},
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				MarkdownDescription: "Source endpoint configuration.",
+				Computed:            true,
+				Attributes:          getFirewallEndpointDataSourceSchemaAttributes("Source"),
+			},
+			"destination": schema.SingleNestedAttribute{
+				MarkdownDescription: "Destination endpoint configuration.",
+				Computed:            true,
+				Attributes:          getFirewallEndpointDataSourceSchemaAttributes("Destination"),
+			},
+			"ip_protocol_scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "IP protocol scope configuration.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"ip_version": schema.StringAttribute{
+						MarkdownDescription: "IP version (ipv4, ipv6, both).",
+						Computed:            true,
+					},
+					"protocol_filter": schema.SingleNestedAttribute{
+						MarkdownDescription: "Protocol filter configuration.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								MarkdownDescription: "Filter type (protocol, protocol_number, preset).",
+								Computed:            true,
+							},
+							"protocol_name": schema.StringAttribute{
+								MarkdownDescription: "Protocol name (tcp, udp, icmp, etc.).",
+								Computed:            true,
+							},
+							"protocol_number": schema.Int64Attribute{
+								MarkdownDescription: "Protocol number.",
+								Computed:            true,
+							},
+							"preset_name": schema.StringAttribute{
+								MarkdownDescription: "Preset name.",
+								Computed:            true,
+							},
+							"match_opposite": schema.BoolAttribute{
+								MarkdownDescription: "Whether to match opposite.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+			"connection_state_filter": schema.ListAttribute{
+				MarkdownDescription: "Connection state filter (new, established, related, invalid).",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auto_allow_established_related": schema.BoolAttribute{
+				MarkdownDescription: "Always `false`; the effective connection states are returned in `connection_state_filter`.",
+				Computed:            true,
+			},
+			"ipsec_filter": schema.StringAttribute{
+				MarkdownDescription: "IPsec filter (match-ipsec, match-none, any).",
+				Computed:            true,
+			},
+			"logging_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether logging is enabled.",
+				Computed:            true,
+			},
+			"schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule configuration. Dates and times are interpreted in the local timezone configured on the site's gateway; there is no per-policy timezone, so time ranges follow that timezone's daylight saving transitions.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "Schedule mode (always, time-range).",
+						Computed:            true,
+					},
+					"repeat_on_days": schema.ListAttribute{
+						MarkdownDescription: "Days to repeat (monday, tuesday, etc.).",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"start_date": schema.StringAttribute{
+						MarkdownDescription: "Start date (YYYY-MM-DD).",
+						Computed:            true,
+					},
+					"stop_date": schema.StringAttribute{
+						MarkdownDescription: "Stop date (YYYY-MM-DD).",
+						Computed:            true,
+					},
+					"start_time": schema.StringAttribute{
+						MarkdownDescription: "Start time (HH:MM, 24-hour, gateway local time).",
+						Computed:            true,
+					},
+					"stop_time": schema.StringAttribute{
+						MarkdownDescription: "Stop time (HH:MM, 24-hour, gateway local time).",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getFirewallEndpointDataSourceSchemaAttributes(direction string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone_id": schema.StringAttribute{
+			MarkdownDescription: direction + " firewall zone ID.",
+			Computed:            true,
+		},
+		"traffic_filter": schema.SingleNestedAttribute{
+			MarkdownDescription: "Traffic filter configuration.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Filter type.",
+					Computed:            true,
+				},
+				"port_filter": schema.SingleNestedAttribute{
+					MarkdownDescription: "Port filter configuration.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Port filter type (items, traffic_matching_list).",
+							Computed:            true,
+						},
+						"match_opposite": schema.BoolAttribute{
+							MarkdownDescription: "Whether to match opposite.",
+							Computed:            true,
+						},
+						"traffic_matching_list_id": schema.StringAttribute{
+							MarkdownDescription: "Traffic matching list ID.",
+							Computed:            true,
+						},
+						"ports": schema.ListAttribute{
+							MarkdownDescription: "List of port numbers.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"port_ranges": schema.ListNestedAttribute{
+							MarkdownDescription: "List of inclusive port ranges.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start": schema.Int64Attribute{
+										MarkdownDescription: "First port of the range.",
+										Computed:            true,
+									},
+									"stop": schema.Int64Attribute{
+										MarkdownDescription: "Last port of the range.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+				"network_filter": schema.SingleNestedAttribute{
+					MarkdownDescription: "Network filter configuration.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"network_ids": schema.ListAttribute{
+							MarkdownDescription: "List of network IDs.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"match_opposite": schema.BoolAttribute{
+							MarkdownDescription: "Whether to match opposite.",
+							Computed:            true,
+						},
+					},
+				},
+				"ip_address_filter": schema.SingleNestedAttribute{
+					MarkdownDescription: "IP address filter configuration.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "IP address filter type (items, traffic_matching_list).",
+							Computed:            true,
+						},
+						"match_opposite": schema.BoolAttribute{
+							MarkdownDescription: "Whether to match opposite.",
+							Computed:            true,
+						},
+						"traffic_matching_list_id": schema.StringAttribute{
+							MarkdownDescription: "Traffic matching list ID.",
+							Computed:            true,
+						},
+						"addresses": schema.ListAttribute{
+							MarkdownDescription: "List of IP addresses, subnets (`10.0.0.0/24`) or ranges (`10.0.0.10-10.0.0.20`).",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+				"region_filter": schema.SingleNestedAttribute{
+					MarkdownDescription: "Region filter configuration.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"regions": schema.ListAttribute{
+							MarkdownDescription: "List of region codes.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	d.client = clients.Network
+}
+
+func (d *FirewallPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading firewall policy", map[string]interface{}{
+		"site_id":   data.SiteID.ValueString(),
+		"policy_id": data.ID.ValueString(),
+	})
+
+	result, err := d.client.GetFirewallPolicy(ctx, networktypes.GetFirewallPolicyRequest{
+		SiteID:   data.SiteID.ValueString(),
+		PolicyID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy: %s", err))
+		return
+	}
+
+	// Reuse the resource mapping so the data source output can be fed back into the resource unchanged.
+	var mapper FirewallPolicyResource
+	mapper.mapResponseToModel(ctx, result, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
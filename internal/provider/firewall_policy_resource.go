@@ -6,16 +6,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,6 +31,19 @@ import (
 
 var _ resource.Resource = &FirewallPolicyResource{}
 var _ resource.ResourceWithImportState = &FirewallPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallPolicyResource{}
+var _ resource.ResourceWithConfigValidators = &FirewallPolicyResource{}
+
+// firewallScheduleDateLayout is the format of schedule start_date and stop_date.
+const firewallScheduleDateLayout = "2006-01-02"
+
+// firewallScheduleTimeLayout is the format of schedule start_time and stop_time.
+const firewallScheduleTimeLayout = "15:04"
+
+const (
+	firewallScheduleModeAlways    = "always"
+	firewallScheduleModeTimeRange = "time-range"
+)
 
 func NewFirewallPolicyResource() resource.Resource {
 	return &FirewallPolicyResource{}
@@ -35,19 +54,28 @@ type FirewallPolicyResource struct {
 }
 
 type FirewallPolicyResourceModel struct {
-	SiteID                types.String `tfsdk:"site_id"`
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Description           types.String `tfsdk:"description"`
-	Enabled               types.Bool   `tfsdk:"enabled"`
-	Action                types.Object `tfsdk:"action"`
-	Source                types.Object `tfsdk:"source"`
-	Destination           types.Object `tfsdk:"destination"`
-	IPProtocolScope       types.Object `tfsdk:"ip_protocol_scope"`
-	ConnectionStateFilter types.List   `tfsdk:"connection_state_filter"`
-	IpsecFilter           types.String `tfsdk:"ipsec_filter"`
-	LoggingEnabled        types.Bool   `tfsdk:"logging_enabled"`
-	Schedule              types.Object `tfsdk:"schedule"`
+	SiteID                      types.String `tfsdk:"site_id"`
+	ID                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	Index                       types.Int64  `tfsdk:"index"`
+	Action                      types.Object `tfsdk:"action"`
+	Source                      types.Object `tfsdk:"source"`
+	Destination                 types.Object `tfsdk:"destination"`
+	IPProtocolScope             types.Object `tfsdk:"ip_protocol_scope"`
+	ConnectionStateFilter       types.List   `tfsdk:"connection_state_filter"`
+	AutoAllowEstablishedRelated types.Bool   `tfsdk:"auto_allow_established_related"`
+	IpsecFilter                 types.String `tfsdk:"ipsec_filter"`
+	LoggingEnabled              types.Bool   `tfsdk:"logging_enabled"`
+	Schedule                    types.Object `tfsdk:"schedule"`
+}
+
+// firewallPolicyResourceData is the resource state: the attributes shared with
+// the unifi_firewall_policy data source plus the resource-only timeouts block.
+type firewallPolicyResourceData struct {
+	FirewallPolicyResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FirewallPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,6 +104,11 @@ func (r *FirewallPolicyResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "The description.",
 				Optional:            true,
 			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "The evaluation position of the policy, as assigned by the controller. The policy API does not accept a position on create or update, so new policies are placed by the controller and this value is read-only.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
 			"enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the policy is enabled. Defaults to `true`.",
 				Optional:            true,
@@ -163,9 +196,19 @@ func (r *FirewallPolicyResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"connection_state_filter": schema.ListAttribute{
-				MarkdownDescription: "Connection state filter (new, established, related, invalid).",
+				MarkdownDescription: "Connection state filter (new, established, related, invalid). When unset and `auto_allow_established_related` is true, this is populated with `[\"new\", \"established\", \"related\"]`.",
 				Optional:            true,
+				Computed:            true,
 				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					connectionStateFilterPlanModifier{},
+				},
+			},
+			"auto_allow_established_related": schema.BoolAttribute{
+				MarkdownDescription: "Whether to match new, established and related connections when `connection_state_filter` is not set. An explicit `connection_state_filter` always takes precedence. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"ipsec_filter": schema.StringAttribute{
 				MarkdownDescription: "IPsec filter (match-ipsec, match-none, any).",
@@ -180,15 +223,15 @@ func (r *FirewallPolicyResource) Schema(ctx context.Context, req resource.Schema
 				Default:             booldefault.StaticBool(false),
 			},
 			"schedule": schema.SingleNestedAttribute{
-				MarkdownDescription: "Schedule configuration.",
+				MarkdownDescription: "Schedule configuration. Dates and times are interpreted in the local timezone configured on the site's gateway; there is no per-policy timezone, so time ranges follow that timezone's daylight saving transitions.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"mode": schema.StringAttribute{
-						MarkdownDescription: "Schedule mode (always, time-range).",
+						MarkdownDescription: "Schedule mode (always, time-range). `time-range` requires `start_time` and `stop_time`; `always` takes no days, dates or times.",
 						Required:            true,
 					},
 					"repeat_on_days": schema.ListAttribute{
-						MarkdownDescription: "Days to repeat (monday, tuesday, etc.).",
+						MarkdownDescription: "Days to repeat (monday, tuesday, etc.). Leave unset with `start_date` and `stop_date` for a one-off date range.",
 						Optional:            true,
 						ElementType:         types.StringType,
 					},
@@ -197,21 +240,70 @@ func (r *FirewallPolicyResource) Schema(ctx context.Context, req resource.Schema
 						Optional:            true,
 					},
 					"stop_date": schema.StringAttribute{
-						MarkdownDescription: "Stop date (YYYY-MM-DD).",
+						MarkdownDescription: "Stop date (YYYY-MM-DD). Must not be before `start_date`.",
 						Optional:            true,
 					},
 					"start_time": schema.StringAttribute{
-						MarkdownDescription: "Start time (HH:MM).",
+						MarkdownDescription: "Start time (HH:MM, 24-hour, gateway local time).",
 						Optional:            true,
 					},
 					"stop_time": schema.StringAttribute{
-						MarkdownDescription: "Stop time (HH:MM).",
+						MarkdownDescription: "Stop time (HH:MM, 24-hour, gateway local time).",
 						Optional:            true,
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// autoAllowConnectionStates are the connection states applied by auto_allow_established_related.
+var autoAllowConnectionStates = []string{"new", "established", "related"}
+
+var _ planmodifier.List = connectionStateFilterPlanModifier{}
+
+// connectionStateFilterPlanModifier plans connection_state_filter from auto_allow_established_related
+// when the list is not configured, and as null otherwise so an unset filter stays unset.
+type connectionStateFilterPlanModifier struct{}
+
+func (m connectionStateFilterPlanModifier) Description(ctx context.Context) string {
+	return "Defaults to new, established and related when auto_allow_established_related is true."
+}
+
+func (m connectionStateFilterPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Defaults to `new`, `established` and `related` when `auto_allow_established_related` is true."
+}
+
+func (m connectionStateFilterPlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var autoAllow types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_allow_established_related"), &autoAllow)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if autoAllow.IsUnknown() {
+		resp.PlanValue = types.ListUnknown(types.StringType)
+		return
 	}
+	if !autoAllow.ValueBool() {
+		resp.PlanValue = types.ListNull(types.StringType)
+		return
+	}
+
+	states, diags := types.ListValueFrom(ctx, types.StringType, autoAllowConnectionStates)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = states
 }
 
 func getTrafficFilterSchemaAttributes() map[string]schema.Attribute {
@@ -229,18 +321,39 @@ func getTrafficFilterSchemaAttributes() map[string]schema.Attribute {
 					Required:            true,
 				},
 				"match_opposite": schema.BoolAttribute{
-					MarkdownDescription: "Whether to match opposite.",
+					MarkdownDescription: "Whether to match opposite. Defaults to `false`.",
 					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
 				},
 				"traffic_matching_list_id": schema.StringAttribute{
 					MarkdownDescription: "Traffic matching list ID.",
 					Optional:            true,
 				},
 				"ports": schema.ListAttribute{
-					MarkdownDescription: "List of ports.",
+					MarkdownDescription: "List of port numbers.",
 					Optional:            true,
 					ElementType:         types.Int64Type,
 				},
+				"port_ranges": schema.ListNestedAttribute{
+					MarkdownDescription: "List of inclusive port ranges, matched in addition to `ports`.",
+					Optional:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"start": schema.Int64Attribute{
+								MarkdownDescription: "First port of the range.",
+								Required:            true,
+								Validators:          []validator.Int64{int64validator.Between(1, 65535)},
+							},
+							"stop": schema.Int64Attribute{
+								MarkdownDescription: "Last port of the range.",
+								Required:            true,
+								Validators:          []validator.Int64{int64validator.Between(1, 65535)},
+							},
+						},
+						Validators: []validator.Object{portRangeOrderValidator{}},
+					},
+				},
 			},
 		},
 		"network_filter": schema.SingleNestedAttribute{
@@ -253,29 +366,33 @@ func getTrafficFilterSchemaAttributes() map[string]schema.Attribute {
 					ElementType:         types.StringType,
 				},
 				"match_opposite": schema.BoolAttribute{
-					MarkdownDescription: "Whether to match opposite.",
+					MarkdownDescription: "Whether to match opposite. Defaults to `false`.",
 					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
 				},
 			},
 		},
 		"ip_address_filter": schema.SingleNestedAttribute{
-			MarkdownDescription: "IP address filter configuration.",
+			MarkdownDescription: "IP address filter configuration. Leave it unset, or set `type` to `any`, to match any address.",
 			Optional:            true,
 			Attributes: map[string]schema.Attribute{
 				"type": schema.StringAttribute{
-					MarkdownDescription: "IP address filter type (items, traffic_matching_list).",
+					MarkdownDescription: "IP address filter type (items, traffic_matching_list, any). `items` requires `addresses`, `traffic_matching_list` requires `traffic_matching_list_id`, and `any` takes neither and is not sent to the controller.",
 					Required:            true,
 				},
 				"match_opposite": schema.BoolAttribute{
-					MarkdownDescription: "Whether to match opposite.",
+					MarkdownDescription: "Whether to match opposite. Defaults to `false`.",
 					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
 				},
 				"traffic_matching_list_id": schema.StringAttribute{
 					MarkdownDescription: "Traffic matching list ID.",
 					Optional:            true,
 				},
 				"addresses": schema.ListAttribute{
-					MarkdownDescription: "List of IP addresses or subnets.",
+					MarkdownDescription: "List of IP addresses, subnets (`10.0.0.0/24`) or ranges (`10.0.0.10-10.0.0.20`). Only used with the `items` type.",
 					Optional:            true,
 					ElementType:         types.StringType,
 				},
@@ -308,15 +425,23 @@ func (r *FirewallPolicyResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *FirewallPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data FirewallPolicyResourceModel
+	var data firewallPolicyResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating firewall policy", map[string]interface{}{"name": data.Name.ValueString()})
 
-	createReq := r.buildCreateRequest(ctx, &data, &resp.Diagnostics)
+	createReq := r.buildCreateRequest(ctx, &data.FirewallPolicyResourceModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -328,11 +453,55 @@ func (r *FirewallPolicyResource) Create(ctx context.Context, req resource.Create
 	}
 
 	data.ID = types.StringValue(result.ID)
+	data.Index = types.Int64Value(int64(result.Index))
+
+	// A policy planned as disabled must not be left active, so reapply the enabled
+	// state when the create response does not match it and read the policy back.
+	if result.Enabled != data.Enabled.ValueBool() {
+		tflog.Debug(ctx, "Firewall policy created with unexpected enabled state, reapplying", map[string]interface{}{
+			"id":      result.ID,
+			"enabled": data.Enabled.ValueBool(),
+		})
+
+		updateReq := r.buildUpdateRequest(ctx, &data.FirewallPolicyResourceModel, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if _, err := r.client.UpdateFirewallPolicy(ctx, updateReq); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply enabled state to firewall policy %s: %s", result.ID, err))
+			// Still record the policy, with the state it was created in, so it is tracked
+			// and fixed on the next apply.
+			data.Enabled = types.BoolValue(result.Enabled)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		policy, err := r.client.GetFirewallPolicy(ctx, networktypes.GetFirewallPolicyRequest{
+			SiteID:   data.SiteID.ValueString(),
+			PolicyID: result.ID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy %s after applying its enabled state: %s", result.ID, err))
+			data.Enabled = types.BoolValue(result.Enabled)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		if policy.Enabled != data.Enabled.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Firewall Policy Enabled State Not Applied",
+				fmt.Sprintf("Firewall policy %s was created, but the controller kept enabled = %t.", result.ID, policy.Enabled),
+			)
+			data.Enabled = types.BoolValue(policy.Enabled)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FirewallPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data FirewallPolicyResourceModel
+	var data firewallPolicyResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -343,22 +512,37 @@ func (r *FirewallPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		PolicyID: data.ID.ValueString(),
 	})
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The firewall policy no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy: %s", err))
 		return
 	}
 
-	r.mapResponseToModel(ctx, result, &data, &resp.Diagnostics)
+	r.mapResponseToModel(ctx, result, &data.FirewallPolicyResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FirewallPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data FirewallPolicyResourceModel
+	var data firewallPolicyResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := r.buildUpdateRequest(ctx, &data, &resp.Diagnostics)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq := r.buildUpdateRequest(ctx, &data.FirewallPolicyResourceModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -373,12 +557,20 @@ func (r *FirewallPolicyResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *FirewallPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FirewallPolicyResourceModel
+	var data firewallPolicyResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.DeleteFirewallPolicy(ctx, networktypes.DeleteFirewallPolicyRequest{
 		SiteID:   data.SiteID.ValueString(),
 		PolicyID: data.ID.ValueString(),
@@ -390,7 +582,191 @@ func (r *FirewallPolicyResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *FirewallPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
+}
+
+func (r *FirewallPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, endpoint := range []string{"source", "destination"} {
+		filterPath := path.Root(endpoint).AtName("traffic_filter").AtName("ip_address_filter")
+		var ipFilterObj types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, filterPath, &ipFilterObj)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if ipFilterObj.IsNull() || ipFilterObj.IsUnknown() {
+			continue
+		}
+
+		var ipFilter FirewallIPAddressFilterModel
+		resp.Diagnostics.Append(ipFilterObj.As(ctx, &ipFilter, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		validateFirewallIPAddressFilter(ipFilter, filterPath, &resp.Diagnostics)
+	}
+}
+
+func (r *FirewallPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		firewallScheduleValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = firewallScheduleValidator{}
+
+// firewallScheduleValidator checks the schedule attributes against the mode and the
+// format of its dates and times.
+type firewallScheduleValidator struct{}
+
+func (v firewallScheduleValidator) Description(ctx context.Context) string {
+	return "Checks that the schedule's dates and times match its mode and are in YYYY-MM-DD and HH:MM format."
+}
+
+func (v firewallScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return "Checks that the schedule's dates and times match its `mode` and are in `YYYY-MM-DD` and `HH:MM` format."
+}
+
+func (v firewallScheduleValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var scheduleObj types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule"), &scheduleObj)...)
+	if resp.Diagnostics.HasError() || scheduleObj.IsNull() || scheduleObj.IsUnknown() {
+		return
+	}
+
+	var schedule FirewallScheduleModel
+	resp.Diagnostics.Append(scheduleObj.As(ctx, &schedule, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateFirewallScheduleMode(schedule, &resp.Diagnostics)
+	validateFirewallScheduleDates(schedule, &resp.Diagnostics)
+	validateFirewallScheduleTimes(schedule, &resp.Diagnostics)
+}
+
+// validateFirewallIPAddressFilter checks that the attributes set on an IP address filter
+// match its type. Types other than the known ones are passed through unchecked.
+func validateFirewallIPAddressFilter(ipFilter FirewallIPAddressFilterModel, filterPath path.Path, diags *diag.Diagnostics) {
+	if ipFilter.Type.IsNull() || ipFilter.Type.IsUnknown() {
+		return
+	}
+	filterType := ipFilter.Type.ValueString()
+	hasAddresses := !ipFilter.Addresses.IsNull() && (ipFilter.Addresses.IsUnknown() || len(ipFilter.Addresses.Elements()) > 0)
+	hasListID := !ipFilter.TrafficMatchingListID.IsNull()
+
+	switch {
+	case strings.EqualFold(filterType, ipAddressFilterTypeItems):
+		if !hasAddresses {
+			diags.AddAttributeError(filterPath.AtName("addresses"), "Missing IP Addresses",
+				fmt.Sprintf("addresses must list at least one address when type is %q.", filterType))
+		}
+		if hasListID {
+			diags.AddAttributeError(filterPath.AtName("traffic_matching_list_id"), "Unexpected Traffic Matching List",
+				fmt.Sprintf("traffic_matching_list_id cannot be set when type is %q.", filterType))
+		}
+	case strings.EqualFold(filterType, ipAddressFilterTypeTrafficMatchingList):
+		if !hasListID {
+			diags.AddAttributeError(filterPath.AtName("traffic_matching_list_id"), "Missing Traffic Matching List",
+				fmt.Sprintf("traffic_matching_list_id must be set when type is %q.", filterType))
+		}
+		if hasAddresses {
+			diags.AddAttributeError(filterPath.AtName("addresses"), "Unexpected IP Addresses",
+				fmt.Sprintf("addresses cannot be set when type is %q.", filterType))
+		}
+	case strings.EqualFold(filterType, ipAddressFilterTypeAny):
+		if hasAddresses || hasListID {
+			diags.AddAttributeError(filterPath, "Unexpected IP Address Filter Attributes",
+				"Neither addresses nor traffic_matching_list_id can be set when type is \"any\".")
+		}
+		if ipFilter.MatchOpposite.ValueBool() {
+			diags.AddAttributeError(filterPath.AtName("match_opposite"), "Invalid Match Opposite",
+				"match_opposite cannot be true when type is \"any\", since the filter would match no traffic.")
+		}
+	}
+}
+
+// validateFirewallScheduleMode checks the schedule attributes against the mode: a
+// time-range schedule needs start_time and stop_time, and an always schedule takes no
+// days, dates or times. Other modes are passed through unchecked.
+func validateFirewallScheduleMode(schedule FirewallScheduleModel, diags *diag.Diagnostics) {
+	if schedule.Mode.IsNull() || schedule.Mode.IsUnknown() {
+		return
+	}
+	schedulePath := path.Root("schedule")
+	mode := schedule.Mode.ValueString()
+
+	switch {
+	case strings.EqualFold(mode, firewallScheduleModeTimeRange):
+		if schedule.StartTime.IsNull() {
+			diags.AddAttributeError(schedulePath.AtName("start_time"), "Missing Schedule Time",
+				fmt.Sprintf("start_time must be set when mode is %q.", mode))
+		}
+		if schedule.StopTime.IsNull() {
+			diags.AddAttributeError(schedulePath.AtName("stop_time"), "Missing Schedule Time",
+				fmt.Sprintf("stop_time must be set when mode is %q.", mode))
+		}
+	case strings.EqualFold(mode, firewallScheduleModeAlways):
+		names := []string{"repeat_on_days", "start_date", "stop_date", "start_time", "stop_time"}
+		values := []attr.Value{schedule.RepeatOnDays, schedule.StartDate, schedule.StopDate, schedule.StartTime, schedule.StopTime}
+		for i, name := range names {
+			if !values[i].IsNull() {
+				diags.AddAttributeError(schedulePath.AtName(name), "Unexpected Schedule Attribute",
+					fmt.Sprintf("%s cannot be set when mode is %q.", name, mode))
+			}
+		}
+	}
+}
+
+// validateFirewallScheduleTimes checks that start_time and stop_time are 24-hour HH:MM values.
+func validateFirewallScheduleTimes(schedule FirewallScheduleModel, diags *diag.Diagnostics) {
+	validateScheduleTime(path.Root("schedule").AtName("start_time"), schedule.StartTime, diags)
+	validateScheduleTime(path.Root("schedule").AtName("stop_time"), schedule.StopTime, diags)
+}
+
+// validateScheduleTime checks that a schedule time is a 24-hour HH:MM value.
+func validateScheduleTime(attrPath path.Path, value types.String, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(firewallScheduleTimeLayout, value.ValueString()); err != nil || len(value.ValueString()) != len(firewallScheduleTimeLayout) {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Schedule Time",
+			fmt.Sprintf("Expected a time in HH:MM (24-hour) format, got: %q", value.ValueString()),
+		)
+	}
+}
+
+// validateFirewallScheduleDates checks the format of start_date and stop_date and that the
+// range is not reversed. A date range without repeat_on_days is a one-off schedule.
+func validateFirewallScheduleDates(schedule FirewallScheduleModel, diags *diag.Diagnostics) {
+	schedulePath := path.Root("schedule")
+
+	parse := func(name string, value types.String) (time.Time, bool) {
+		if value.IsNull() || value.IsUnknown() {
+			return time.Time{}, false
+		}
+		date, err := time.Parse(firewallScheduleDateLayout, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				schedulePath.AtName(name),
+				"Invalid Schedule Date",
+				fmt.Sprintf("Expected a date in YYYY-MM-DD format, got: %q", value.ValueString()),
+			)
+			return time.Time{}, false
+		}
+		return date, true
+	}
+
+	startDate, startOK := parse("start_date", schedule.StartDate)
+	stopDate, stopOK := parse("stop_date", schedule.StopDate)
+	if startOK && stopOK && startDate.After(stopDate) {
+		diags.AddAttributeError(
+			schedulePath.AtName("stop_date"),
+			"Invalid Schedule Date Range",
+			fmt.Sprintf("start_date %q must be on or before stop_date %q.", schedule.StartDate.ValueString(), schedule.StopDate.ValueString()),
+		)
+	}
 }
 
 type FirewallActionModel struct {
@@ -403,6 +779,43 @@ type FirewallEndpointModel struct {
 	TrafficFilter types.Object `tfsdk:"traffic_filter"`
 }
 
+type FirewallTrafficFilterModel struct {
+	Type            types.String `tfsdk:"type"`
+	PortFilter      types.Object `tfsdk:"port_filter"`
+	NetworkFilter   types.Object `tfsdk:"network_filter"`
+	IPAddressFilter types.Object `tfsdk:"ip_address_filter"`
+	RegionFilter    types.Object `tfsdk:"region_filter"`
+}
+
+type FirewallPortFilterModel struct {
+	Type                  types.String `tfsdk:"type"`
+	MatchOpposite         types.Bool   `tfsdk:"match_opposite"`
+	TrafficMatchingListID types.String `tfsdk:"traffic_matching_list_id"`
+	Ports                 types.List   `tfsdk:"ports"`
+	PortRanges            types.List   `tfsdk:"port_ranges"`
+}
+
+type FirewallPortRangeModel struct {
+	Start types.Int64 `tfsdk:"start"`
+	Stop  types.Int64 `tfsdk:"stop"`
+}
+
+type FirewallNetworkFilterModel struct {
+	NetworkIDs    types.List `tfsdk:"network_ids"`
+	MatchOpposite types.Bool `tfsdk:"match_opposite"`
+}
+
+type FirewallIPAddressFilterModel struct {
+	Type                  types.String `tfsdk:"type"`
+	MatchOpposite         types.Bool   `tfsdk:"match_opposite"`
+	TrafficMatchingListID types.String `tfsdk:"traffic_matching_list_id"`
+	Addresses             types.List   `tfsdk:"addresses"`
+}
+
+type FirewallRegionFilterModel struct {
+	Regions types.List `tfsdk:"regions"`
+}
+
 type FirewallIPProtocolScopeModel struct {
 	IPVersion      types.String `tfsdk:"ip_version"`
 	ProtocolFilter types.Object `tfsdk:"protocol_filter"`
@@ -447,7 +860,7 @@ func (r *FirewallPolicyResource) buildCreateRequest(ctx context.Context, data *F
 	if !data.IPProtocolScope.IsNull() {
 		createReq.IPProtocolScope = r.buildIPProtocolScope(ctx, data.IPProtocolScope, diags)
 	}
-	if !data.ConnectionStateFilter.IsNull() {
+	if !data.ConnectionStateFilter.IsNull() && !data.ConnectionStateFilter.IsUnknown() {
 		var states []string
 		diags.Append(data.ConnectionStateFilter.ElementsAs(ctx, &states, false)...)
 		createReq.ConnectionStateFilter = states
@@ -482,7 +895,7 @@ func (r *FirewallPolicyResource) buildUpdateRequest(ctx context.Context, data *F
 	if !data.IPProtocolScope.IsNull() {
 		updateReq.IPProtocolScope = r.buildIPProtocolScope(ctx, data.IPProtocolScope, diags)
 	}
-	if !data.ConnectionStateFilter.IsNull() {
+	if !data.ConnectionStateFilter.IsNull() && !data.ConnectionStateFilter.IsUnknown() {
 		var states []string
 		diags.Append(data.ConnectionStateFilter.ElementsAs(ctx, &states, false)...)
 		updateReq.ConnectionStateFilter = states
@@ -521,10 +934,129 @@ func (r *FirewallPolicyResource) buildEndpoint(ctx context.Context, endpointObj
 	result := &networktypes.FirewallPolicyEndpoint{
 		ZoneID: endpoint.ZoneID.ValueString(),
 	}
-	// Traffic filter would be built here if needed
+	if !endpoint.TrafficFilter.IsNull() && !endpoint.TrafficFilter.IsUnknown() {
+		result.TrafficFilter = r.buildTrafficFilter(ctx, endpoint.TrafficFilter, diags)
+	}
+	return result
+}
+
+// Item types used by the API for the flattened ports and addresses lists.
+const (
+	portFilterItemTypeNumber       = "PORT_NUMBER"
+	portFilterItemTypeRange        = "PORT_NUMBER_RANGE"
+	ipAddressFilterItemTypeAddress = "IP_ADDRESS"
+	ipAddressFilterItemTypeSubnet  = "SUBNET"
+	ipAddressFilterItemTypeRange   = "IP_ADDRESS_RANGE"
+)
+
+// IP address filter types accepted in configuration. An `any` filter is not sent to
+// the API, which matches any address when a traffic filter has no IP address filter.
+const (
+	ipAddressFilterTypeItems               = "items"
+	ipAddressFilterTypeTrafficMatchingList = "traffic_matching_list"
+	ipAddressFilterTypeAny                 = "any"
+)
+
+func (r *FirewallPolicyResource) buildTrafficFilter(ctx context.Context, filterObj types.Object, diags *diag.Diagnostics) *networktypes.TrafficFilter {
+	var filter FirewallTrafficFilterModel
+	diags.Append(filterObj.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := &networktypes.TrafficFilter{
+		Type: filter.Type.ValueString(),
+	}
+
+	if !filter.PortFilter.IsNull() && !filter.PortFilter.IsUnknown() {
+		var portFilter FirewallPortFilterModel
+		diags.Append(filter.PortFilter.As(ctx, &portFilter, basetypes.ObjectAsOptions{})...)
+		result.PortFilter = &networktypes.FirewallPortFilter{
+			Type:                  portFilter.Type.ValueString(),
+			MatchOpposite:         portFilter.MatchOpposite.ValueBool(),
+			TrafficMatchingListID: portFilter.TrafficMatchingListID.ValueString(),
+		}
+		for _, port := range filterPorts(ctx, portFilter.Ports, diags) {
+			value := port
+			result.PortFilter.Items = append(result.PortFilter.Items, networktypes.FirewallPortFilterItem{
+				Type:  portFilterItemTypeNumber,
+				Value: &value,
+			})
+		}
+		if !portFilter.PortRanges.IsNull() && !portFilter.PortRanges.IsUnknown() {
+			var ranges []FirewallPortRangeModel
+			diags.Append(portFilter.PortRanges.ElementsAs(ctx, &ranges, false)...)
+			for _, portRange := range ranges {
+				start := int(portRange.Start.ValueInt64())
+				stop := int(portRange.Stop.ValueInt64())
+				result.PortFilter.Items = append(result.PortFilter.Items, networktypes.FirewallPortFilterItem{
+					Type:  portFilterItemTypeRange,
+					Start: &start,
+					Stop:  &stop,
+				})
+			}
+		}
+	}
+
+	if !filter.NetworkFilter.IsNull() && !filter.NetworkFilter.IsUnknown() {
+		var networkFilter FirewallNetworkFilterModel
+		diags.Append(filter.NetworkFilter.As(ctx, &networkFilter, basetypes.ObjectAsOptions{})...)
+		result.NetworkFilter = &networktypes.FirewallNetworkFilter{
+			MatchOpposite: networkFilter.MatchOpposite.ValueBool(),
+		}
+		diags.Append(networkFilter.NetworkIDs.ElementsAs(ctx, &result.NetworkFilter.NetworkIDs, false)...)
+	}
+
+	if !filter.IPAddressFilter.IsNull() && !filter.IPAddressFilter.IsUnknown() && !isAnyIPAddressFilter(ctx, filter.IPAddressFilter) {
+		var ipFilter FirewallIPAddressFilterModel
+		diags.Append(filter.IPAddressFilter.As(ctx, &ipFilter, basetypes.ObjectAsOptions{})...)
+		result.IpAddressFilter = &networktypes.FirewallIPAddressFilter{
+			Type:                  ipFilter.Type.ValueString(),
+			MatchOpposite:         ipFilter.MatchOpposite.ValueBool(),
+			TrafficMatchingListID: ipFilter.TrafficMatchingListID.ValueString(),
+		}
+		for _, address := range filterStrings(ctx, ipFilter.Addresses, diags) {
+			result.IpAddressFilter.Items = append(result.IpAddressFilter.Items, buildIPAddressFilterItem(address))
+		}
+	}
+
+	if !filter.RegionFilter.IsNull() && !filter.RegionFilter.IsUnknown() {
+		var regionFilter FirewallRegionFilterModel
+		diags.Append(filter.RegionFilter.As(ctx, &regionFilter, basetypes.ObjectAsOptions{})...)
+		result.RegionFilter = &networktypes.FirewallRegionFilter{}
+		diags.Append(regionFilter.Regions.ElementsAs(ctx, &result.RegionFilter.Regions, false)...)
+	}
+
 	return result
 }
 
+// isAnyIPAddressFilter reports whether an ip_address_filter object has the `any` type.
+func isAnyIPAddressFilter(ctx context.Context, ipFilterObj types.Object) bool {
+	if ipFilterObj.IsNull() || ipFilterObj.IsUnknown() {
+		return false
+	}
+	var ipFilter FirewallIPAddressFilterModel
+	if d := ipFilterObj.As(ctx, &ipFilter, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); d.HasError() {
+		return false
+	}
+	return strings.EqualFold(ipFilter.Type.ValueString(), ipAddressFilterTypeAny)
+}
+
+// buildIPAddressFilterItem converts an address, subnet or `start-stop` range into an API item.
+func buildIPAddressFilterItem(address string) networktypes.FirewallIPAddressFilterItem {
+	if start, stop, ok := strings.Cut(address, "-"); ok {
+		return networktypes.FirewallIPAddressFilterItem{
+			Type:  ipAddressFilterItemTypeRange,
+			Start: strings.TrimSpace(start),
+			Stop:  strings.TrimSpace(stop),
+		}
+	}
+	if strings.Contains(address, "/") {
+		return networktypes.FirewallIPAddressFilterItem{Type: ipAddressFilterItemTypeSubnet, Value: address}
+	}
+	return networktypes.FirewallIPAddressFilterItem{Type: ipAddressFilterItemTypeAddress, Value: address}
+}
+
 func (r *FirewallPolicyResource) buildIPProtocolScope(ctx context.Context, scopeObj types.Object, diags *diag.Diagnostics) *networktypes.FirewallIPProtocolScope {
 	var scope FirewallIPProtocolScopeModel
 	diags.Append(scopeObj.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
@@ -579,7 +1111,8 @@ func (r *FirewallPolicyResource) buildSchedule(ctx context.Context, scheduleObj
 		StopDate:  schedule.StopDate.ValueString(),
 	}
 
-	if !schedule.RepeatOnDays.IsNull() {
+	// Without repeat_on_days a time-range schedule applies once, between start_date and stop_date.
+	if !schedule.RepeatOnDays.IsNull() && !schedule.RepeatOnDays.IsUnknown() {
 		var days []string
 		diags.Append(schedule.RepeatOnDays.ElementsAs(ctx, &days, false)...)
 		result.RepeatOnDays = days
@@ -597,16 +1130,17 @@ func (r *FirewallPolicyResource) buildSchedule(ctx context.Context, scheduleObj
 
 func (r *FirewallPolicyResource) mapResponseToModel(ctx context.Context, resp *networktypes.FirewallPolicy, data *FirewallPolicyResourceModel, diags *diag.Diagnostics) {
 	data.Name = types.StringValue(resp.Name)
-	data.Description = types.StringValue(resp.Description)
+	// The API returns an empty description when none is set. Map it to null unless
+	// the configuration explicitly set an empty string.
+	if resp.Description != "" || data.Description.ValueString() != "" {
+		data.Description = stringValueOrNull(resp.Description)
+	}
 	data.Enabled = types.BoolValue(resp.Enabled)
+	data.Index = types.Int64Value(int64(resp.Index))
 	data.LoggingEnabled = types.BoolValue(resp.LoggingEnabled)
 	data.IpsecFilter = types.StringValue(resp.IpsecFilter)
 
 	if resp.Action != nil {
-		actionAttrTypes := map[string]attr.Type{
-			"type":                 types.StringType,
-			"allow_return_traffic": types.BoolType,
-		}
 		actionAttrValues := map[string]attr.Value{
 			"type": types.StringValue(resp.Action.Type),
 		}
@@ -615,84 +1149,264 @@ func (r *FirewallPolicyResource) mapResponseToModel(ctx context.Context, resp *n
 		} else {
 			actionAttrValues["allow_return_traffic"] = types.BoolNull()
 		}
-		actionObj, d := types.ObjectValue(actionAttrTypes, actionAttrValues)
+		actionObj, d := types.ObjectValue(getFirewallActionAttrTypes(), actionAttrValues)
 		diags.Append(d...)
 		data.Action = actionObj
+	} else {
+		data.Action = types.ObjectNull(getFirewallActionAttrTypes())
 	}
 
 	if resp.Source != nil {
-		data.Source = r.mapEndpointToObject(ctx, resp.Source, diags)
+		data.Source = r.mapEndpointToObject(ctx, resp.Source, data.Source, diags)
+	} else {
+		data.Source = types.ObjectNull(getFirewallEndpointAttrTypes())
 	}
 	if resp.Destination != nil {
-		data.Destination = r.mapEndpointToObject(ctx, resp.Destination, diags)
+		data.Destination = r.mapEndpointToObject(ctx, resp.Destination, data.Destination, diags)
+	} else {
+		data.Destination = types.ObjectNull(getFirewallEndpointAttrTypes())
 	}
 	if resp.IPProtocolScope != nil {
 		data.IPProtocolScope = r.mapIPProtocolScopeToObject(ctx, resp.IPProtocolScope, diags)
+	} else {
+		data.IPProtocolScope = types.ObjectNull(getIPProtocolScopeAttrTypes())
 	}
+
+	// Not part of the API; imported policies start with the default.
+	if data.AutoAllowEstablishedRelated.IsNull() {
+		data.AutoAllowEstablishedRelated = types.BoolValue(false)
+	}
+
 	if len(resp.ConnectionStateFilter) > 0 {
 		states, d := types.ListValueFrom(ctx, types.StringType, resp.ConnectionStateFilter)
 		diags.Append(d...)
 		data.ConnectionStateFilter = states
+	} else {
+		data.ConnectionStateFilter = types.ListNull(types.StringType)
 	}
 	if resp.Schedule != nil {
 		data.Schedule = r.mapScheduleToObject(ctx, resp.Schedule, diags)
+	} else {
+		data.Schedule = types.ObjectNull(getFirewallScheduleAttrTypes())
+	}
+}
+
+func getFirewallActionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":                 types.StringType,
+		"allow_return_traffic": types.BoolType,
 	}
 }
 
-func (r *FirewallPolicyResource) mapEndpointToObject(ctx context.Context, endpoint *networktypes.FirewallPolicyEndpoint, diags *diag.Diagnostics) types.Object {
-	attrTypes := map[string]attr.Type{
+func getFirewallEndpointAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
 		"zone_id":        types.StringType,
 		"traffic_filter": types.ObjectType{AttrTypes: getTrafficFilterAttrTypes()},
 	}
+}
+
+// mapEndpointToObject maps an endpoint returned by the API. prior is the endpoint from
+// the plan or state, used to keep an `any` IP address filter that is not sent to the API.
+func (r *FirewallPolicyResource) mapEndpointToObject(ctx context.Context, endpoint *networktypes.FirewallPolicyEndpoint, prior types.Object, diags *diag.Diagnostics) types.Object {
 	attrValues := map[string]attr.Value{
-		"zone_id":        types.StringValue(endpoint.ZoneID),
-		"traffic_filter": types.ObjectNull(getTrafficFilterAttrTypes()),
+		"zone_id": types.StringValue(endpoint.ZoneID),
 	}
 
-	obj, d := types.ObjectValue(attrTypes, attrValues)
+	if endpoint.TrafficFilter != nil {
+		var priorIPFilter types.Object
+		if !prior.IsNull() && !prior.IsUnknown() {
+			var priorEndpoint FirewallEndpointModel
+			if d := prior.As(ctx, &priorEndpoint, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); !d.HasError() && !priorEndpoint.TrafficFilter.IsNull() && !priorEndpoint.TrafficFilter.IsUnknown() {
+				var priorFilter FirewallTrafficFilterModel
+				if d := priorEndpoint.TrafficFilter.As(ctx, &priorFilter, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); !d.HasError() {
+					priorIPFilter = priorFilter.IPAddressFilter
+				}
+			}
+		}
+		attrValues["traffic_filter"] = r.mapTrafficFilterToObject(ctx, endpoint.TrafficFilter, priorIPFilter, diags)
+	} else {
+		attrValues["traffic_filter"] = types.ObjectNull(getTrafficFilterAttrTypes())
+	}
+
+	obj, d := types.ObjectValue(getFirewallEndpointAttrTypes(), attrValues)
+	diags.Append(d...)
+	return obj
+}
+
+func (r *FirewallPolicyResource) mapTrafficFilterToObject(ctx context.Context, filter *networktypes.TrafficFilter, priorIPFilter types.Object, diags *diag.Diagnostics) types.Object {
+	attrValues := map[string]attr.Value{
+		"type": types.StringValue(filter.Type),
+	}
+
+	if filter.PortFilter != nil {
+		var ports []int
+		var ranges []FirewallPortRangeModel
+		// A filter that references a traffic matching list is identified by the list ID alone;
+		// any items the API resolves from the list are not part of the configuration.
+		var portItems []networktypes.FirewallPortFilterItem
+		if filter.PortFilter.TrafficMatchingListID == "" {
+			portItems = filter.PortFilter.Items
+		}
+		for _, item := range portItems {
+			switch {
+			case item.Value != nil:
+				ports = append(ports, *item.Value)
+			case item.Start != nil && item.Stop != nil:
+				ranges = append(ranges, FirewallPortRangeModel{
+					Start: types.Int64Value(int64(*item.Start)),
+					Stop:  types.Int64Value(int64(*item.Stop)),
+				})
+			default:
+				tflog.Debug(ctx, "Skipping firewall port filter item without a value or range", map[string]interface{}{
+					"type": item.Type,
+				})
+			}
+		}
+		portValues := map[string]attr.Value{
+			"type":                     types.StringValue(filter.PortFilter.Type),
+			"match_opposite":           types.BoolValue(filter.PortFilter.MatchOpposite),
+			"traffic_matching_list_id": stringValueOrNull(filter.PortFilter.TrafficMatchingListID),
+			"ports":                    filterPortListOrNull(ctx, ports, diags),
+			"port_ranges":              types.ListNull(types.ObjectType{AttrTypes: getPortRangeAttrTypes()}),
+		}
+		if len(ranges) > 0 {
+			rangeList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: getPortRangeAttrTypes()}, ranges)
+			diags.Append(d...)
+			portValues["port_ranges"] = rangeList
+		}
+		portObj, d := types.ObjectValue(getPortFilterAttrTypes(), portValues)
+		diags.Append(d...)
+		attrValues["port_filter"] = portObj
+	} else {
+		attrValues["port_filter"] = types.ObjectNull(getPortFilterAttrTypes())
+	}
+
+	if filter.NetworkFilter != nil {
+		networkIDs, d := types.ListValueFrom(ctx, types.StringType, filter.NetworkFilter.NetworkIDs)
+		diags.Append(d...)
+		networkObj, d := types.ObjectValue(getNetworkFilterAttrTypes(), map[string]attr.Value{
+			"network_ids":    networkIDs,
+			"match_opposite": types.BoolValue(filter.NetworkFilter.MatchOpposite),
+		})
+		diags.Append(d...)
+		attrValues["network_filter"] = networkObj
+	} else {
+		attrValues["network_filter"] = types.ObjectNull(getNetworkFilterAttrTypes())
+	}
+
+	if filter.IpAddressFilter != nil {
+		var addresses []string
+		var addressItems []networktypes.FirewallIPAddressFilterItem
+		if filter.IpAddressFilter.TrafficMatchingListID == "" {
+			addressItems = filter.IpAddressFilter.Items
+		}
+		for _, item := range addressItems {
+			if item.Type == ipAddressFilterItemTypeRange {
+				addresses = append(addresses, item.Start+"-"+item.Stop)
+				continue
+			}
+			addresses = append(addresses, item.Value)
+		}
+		ipValues := map[string]attr.Value{
+			"type":                     types.StringValue(filter.IpAddressFilter.Type),
+			"match_opposite":           types.BoolValue(filter.IpAddressFilter.MatchOpposite),
+			"traffic_matching_list_id": stringValueOrNull(filter.IpAddressFilter.TrafficMatchingListID),
+			"addresses":                filterStringListOrNull(ctx, addresses, diags),
+		}
+		ipObj, d := types.ObjectValue(getIPAddressFilterAttrTypes(), ipValues)
+		diags.Append(d...)
+		attrValues["ip_address_filter"] = ipObj
+	} else if isAnyIPAddressFilter(ctx, priorIPFilter) {
+		// An `any` filter is never sent, so the API returning none means it is still in effect.
+		attrValues["ip_address_filter"] = priorIPFilter
+	} else {
+		attrValues["ip_address_filter"] = types.ObjectNull(getIPAddressFilterAttrTypes())
+	}
+
+	if filter.RegionFilter != nil {
+		regions, d := types.ListValueFrom(ctx, types.StringType, filter.RegionFilter.Regions)
+		diags.Append(d...)
+		regionObj, d := types.ObjectValue(getRegionFilterAttrTypes(), map[string]attr.Value{
+			"regions": regions,
+		})
+		diags.Append(d...)
+		attrValues["region_filter"] = regionObj
+	} else {
+		attrValues["region_filter"] = types.ObjectNull(getRegionFilterAttrTypes())
+	}
+
+	obj, d := types.ObjectValue(getTrafficFilterAttrTypes(), attrValues)
 	diags.Append(d...)
 	return obj
 }
 
 func getTrafficFilterAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"type": types.StringType,
-		"port_filter": types.ObjectType{AttrTypes: map[string]attr.Type{
-			"type":                     types.StringType,
-			"match_opposite":           types.BoolType,
-			"traffic_matching_list_id": types.StringType,
-			"ports":                    types.ListType{ElemType: types.Int64Type},
-		}},
-		"network_filter": types.ObjectType{AttrTypes: map[string]attr.Type{
-			"network_ids":    types.ListType{ElemType: types.StringType},
-			"match_opposite": types.BoolType,
-		}},
-		"ip_address_filter": types.ObjectType{AttrTypes: map[string]attr.Type{
-			"type":                     types.StringType,
-			"match_opposite":           types.BoolType,
-			"traffic_matching_list_id": types.StringType,
-			"addresses":                types.ListType{ElemType: types.StringType},
-		}},
-		"region_filter": types.ObjectType{AttrTypes: map[string]attr.Type{
-			"regions": types.ListType{ElemType: types.StringType},
-		}},
+		"type":              types.StringType,
+		"port_filter":       types.ObjectType{AttrTypes: getPortFilterAttrTypes()},
+		"network_filter":    types.ObjectType{AttrTypes: getNetworkFilterAttrTypes()},
+		"ip_address_filter": types.ObjectType{AttrTypes: getIPAddressFilterAttrTypes()},
+		"region_filter":     types.ObjectType{AttrTypes: getRegionFilterAttrTypes()},
 	}
 }
 
-func (r *FirewallPolicyResource) mapIPProtocolScopeToObject(ctx context.Context, scope *networktypes.FirewallIPProtocolScope, diags *diag.Diagnostics) types.Object {
-	protocolFilterAttrTypes := map[string]attr.Type{
+func getPortFilterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":                     types.StringType,
+		"match_opposite":           types.BoolType,
+		"traffic_matching_list_id": types.StringType,
+		"ports":                    types.ListType{ElemType: types.Int64Type},
+		"port_ranges":              types.ListType{ElemType: types.ObjectType{AttrTypes: getPortRangeAttrTypes()}},
+	}
+}
+
+func getPortRangeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start": types.Int64Type,
+		"stop":  types.Int64Type,
+	}
+}
+
+func getNetworkFilterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"network_ids":    types.ListType{ElemType: types.StringType},
+		"match_opposite": types.BoolType,
+	}
+}
+
+func getIPAddressFilterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":                     types.StringType,
+		"match_opposite":           types.BoolType,
+		"traffic_matching_list_id": types.StringType,
+		"addresses":                types.ListType{ElemType: types.StringType},
+	}
+}
+
+func getRegionFilterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"regions": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func getProtocolFilterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
 		"type":            types.StringType,
 		"protocol_name":   types.StringType,
 		"protocol_number": types.Int64Type,
 		"preset_name":     types.StringType,
 		"match_opposite":  types.BoolType,
 	}
+}
 
-	attrTypes := map[string]attr.Type{
+func getIPProtocolScopeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
 		"ip_version":      types.StringType,
-		"protocol_filter": types.ObjectType{AttrTypes: protocolFilterAttrTypes},
+		"protocol_filter": types.ObjectType{AttrTypes: getProtocolFilterAttrTypes()},
 	}
+}
 
+func (r *FirewallPolicyResource) mapIPProtocolScopeToObject(ctx context.Context, scope *networktypes.FirewallIPProtocolScope, diags *diag.Diagnostics) types.Object {
 	attrValues := map[string]attr.Value{
 		"ip_version": types.StringValue(scope.IPVersion),
 	}
@@ -722,20 +1436,20 @@ func (r *FirewallPolicyResource) mapIPProtocolScopeToObject(ctx context.Context,
 			pfAttrValues["match_opposite"] = types.BoolNull()
 		}
 
-		pfObj, d := types.ObjectValue(protocolFilterAttrTypes, pfAttrValues)
+		pfObj, d := types.ObjectValue(getProtocolFilterAttrTypes(), pfAttrValues)
 		diags.Append(d...)
 		attrValues["protocol_filter"] = pfObj
 	} else {
-		attrValues["protocol_filter"] = types.ObjectNull(protocolFilterAttrTypes)
+		attrValues["protocol_filter"] = types.ObjectNull(getProtocolFilterAttrTypes())
 	}
 
-	obj, d := types.ObjectValue(attrTypes, attrValues)
+	obj, d := types.ObjectValue(getIPProtocolScopeAttrTypes(), attrValues)
 	diags.Append(d...)
 	return obj
 }
 
-func (r *FirewallPolicyResource) mapScheduleToObject(ctx context.Context, schedule *networktypes.FirewallSchedule, diags *diag.Diagnostics) types.Object {
-	attrTypes := map[string]attr.Type{
+func getFirewallScheduleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
 		"mode":           types.StringType,
 		"repeat_on_days": types.ListType{ElemType: types.StringType},
 		"start_date":     types.StringType,
@@ -743,11 +1457,13 @@ func (r *FirewallPolicyResource) mapScheduleToObject(ctx context.Context, schedu
 		"start_time":     types.StringType,
 		"stop_time":      types.StringType,
 	}
+}
 
+func (r *FirewallPolicyResource) mapScheduleToObject(ctx context.Context, schedule *networktypes.FirewallSchedule, diags *diag.Diagnostics) types.Object {
 	attrValues := map[string]attr.Value{
 		"mode":       types.StringValue(schedule.Mode),
-		"start_date": types.StringValue(schedule.StartDate),
-		"stop_date":  types.StringValue(schedule.StopDate),
+		"start_date": stringValueOrNull(schedule.StartDate),
+		"stop_date":  stringValueOrNull(schedule.StopDate),
 	}
 
 	if len(schedule.RepeatOnDays) > 0 {
@@ -759,14 +1475,14 @@ func (r *FirewallPolicyResource) mapScheduleToObject(ctx context.Context, schedu
 	}
 
 	if schedule.TimeFilter != nil {
-		attrValues["start_time"] = types.StringValue(schedule.TimeFilter.StartTime)
-		attrValues["stop_time"] = types.StringValue(schedule.TimeFilter.StopTime)
+		attrValues["start_time"] = stringValueOrNull(schedule.TimeFilter.StartTime)
+		attrValues["stop_time"] = stringValueOrNull(schedule.TimeFilter.StopTime)
 	} else {
 		attrValues["start_time"] = types.StringNull()
 		attrValues["stop_time"] = types.StringNull()
 	}
 
-	obj, d := types.ObjectValue(attrTypes, attrValues)
+	obj, d := types.ObjectValue(getFirewallScheduleAttrTypes(), attrValues)
 	diags.Append(d...)
 	return obj
 }
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+// TestFirewallPolicyMapTrafficFilterListReference checks that a filter referencing a traffic
+// matching list is read back as the list ID alone, even when the API also returns the items
+// it resolved from the list. An imported policy then plans clean against a configuration
+// that only sets traffic_matching_list_id.
+func TestFirewallPolicyMapTrafficFilterListReference(t *testing.T) {
+	ctx := context.Background()
+	r := &FirewallPolicyResource{}
+	var diags diag.Diagnostics
+
+	filter := &networktypes.TrafficFilter{
+		Type: "PORT",
+		PortFilter: &networktypes.FirewallPortFilter{
+			Type:                  "TRAFFIC_MATCHING_LIST",
+			TrafficMatchingListID: "port-list-1",
+			Items: []networktypes.FirewallPortFilterItem{
+				{Type: "PORT_NUMBER", Value: intPtr(443)},
+				{Type: "PORT_NUMBER_RANGE", Start: intPtr(8000), Stop: intPtr(8080)},
+			},
+		},
+		IpAddressFilter: &networktypes.FirewallIPAddressFilter{
+			Type:                  ipAddressFilterTypeTrafficMatchingList,
+			MatchOpposite:         true,
+			TrafficMatchingListID: "address-list-1",
+			Items: []networktypes.FirewallIPAddressFilterItem{
+				{Type: ipAddressFilterItemTypeAddress, Value: "192.168.1.10"},
+				{Type: ipAddressFilterItemTypeSubnet, Value: "10.0.0.0/24"},
+			},
+		},
+	}
+
+	obj := r.mapTrafficFilterToObject(ctx, filter, types.ObjectNull(getIPAddressFilterAttrTypes()), &diags)
+
+	var trafficFilter FirewallTrafficFilterModel
+	diags.Append(obj.As(ctx, &trafficFilter, basetypes.ObjectAsOptions{})...)
+	var portFilter FirewallPortFilterModel
+	diags.Append(trafficFilter.PortFilter.As(ctx, &portFilter, basetypes.ObjectAsOptions{})...)
+	var ipFilter FirewallIPAddressFilterModel
+	diags.Append(trafficFilter.IPAddressFilter.As(ctx, &ipFilter, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := portFilter.TrafficMatchingListID.ValueString(); got != "port-list-1" {
+		t.Errorf("port_filter.traffic_matching_list_id = %q, want %q", got, "port-list-1")
+	}
+	if !portFilter.Ports.IsNull() {
+		t.Errorf("port_filter.ports = %s, want null", portFilter.Ports)
+	}
+	if !portFilter.PortRanges.IsNull() {
+		t.Errorf("port_filter.port_ranges = %s, want null", portFilter.PortRanges)
+	}
+
+	if got := ipFilter.TrafficMatchingListID.ValueString(); got != "address-list-1" {
+		t.Errorf("ip_address_filter.traffic_matching_list_id = %q, want %q", got, "address-list-1")
+	}
+	if !ipFilter.MatchOpposite.ValueBool() {
+		t.Errorf("ip_address_filter.match_opposite = %s, want true", ipFilter.MatchOpposite)
+	}
+	if !ipFilter.Addresses.IsNull() {
+		t.Errorf("ip_address_filter.addresses = %s, want null", ipFilter.Addresses)
+	}
+}
+
+func TestFirewallScheduleValidator(t *testing.T) {
+	r := &FirewallPolicyResource{}
+	scheduleType := testAttributeObjectType(t, r, "schedule")
+
+	cases := map[string]struct {
+		schedule   map[string]tftypes.Value
+		wantErrors int
+	}{
+		"time range": {
+			schedule: map[string]tftypes.Value{
+				"mode":       tftypes.NewValue(tftypes.String, "time-range"),
+				"start_time": tftypes.NewValue(tftypes.String, "08:00"),
+				"stop_time":  tftypes.NewValue(tftypes.String, "17:30"),
+			},
+		},
+		"time range without stop_time": {
+			schedule: map[string]tftypes.Value{
+				"mode":       tftypes.NewValue(tftypes.String, "time-range"),
+				"start_time": tftypes.NewValue(tftypes.String, "08:00"),
+			},
+			wantErrors: 1,
+		},
+		"always with a date range": {
+			schedule: map[string]tftypes.Value{
+				"mode":       tftypes.NewValue(tftypes.String, "always"),
+				"start_date": tftypes.NewValue(tftypes.String, "2025-01-01"),
+				"stop_date":  tftypes.NewValue(tftypes.String, "2025-01-31"),
+			},
+			wantErrors: 2,
+		},
+		"malformed time": {
+			schedule: map[string]tftypes.Value{
+				"mode":       tftypes.NewValue(tftypes.String, "time-range"),
+				"start_time": tftypes.NewValue(tftypes.String, "8:00"),
+				"stop_time":  tftypes.NewValue(tftypes.String, "24:00"),
+			},
+			wantErrors: 2,
+		},
+		"unknown time": {
+			schedule: map[string]tftypes.Value{
+				"mode":       tftypes.NewValue(tftypes.String, "time-range"),
+				"start_time": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"stop_time":  tftypes.NewValue(tftypes.String, "17:30"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := testResourceConfig(t, r, map[string]tftypes.Value{
+				"schedule": testObjectValue(scheduleType, tc.schedule),
+			})
+			resp := validateResourceConfig(t, firewallScheduleValidator{}, config)
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -59,7 +58,7 @@ func (r *FirewallZoneResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:            true,
 			},
 			"network_ids": schema.ListAttribute{
-				MarkdownDescription: "List of network IDs in this zone.",
+				MarkdownDescription: "List of network IDs in this zone. Do not also set `zone_id` on the corresponding `unifi_network` resources, as both control the same membership.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
@@ -124,6 +123,13 @@ func (r *FirewallZoneResource) Read(ctx context.Context, req resource.ReadReques
 		ZoneID: data.ID.ValueString(),
 	})
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The firewall zone no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall zone: %s", err))
 		return
 	}
@@ -185,5 +191,5 @@ func (r *FirewallZoneResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *FirewallZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
 }
@@ -75,16 +75,19 @@ func (d *FirewallZonesDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	result, err := d.client.ListFirewallZones(ctx, networktypes.ListFirewallZonesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.FirewallZone], error) {
+		return d.client.ListFirewallZones(ctx, networktypes.ListFirewallZonesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall zones: %s", err))
 		return
 	}
 
-	data.Zones = make([]FirewallZoneSummary, 0, len(result.Data))
-	for _, z := range result.Data {
+	data.Zones = make([]FirewallZoneSummary, 0, len(result))
+	for _, z := range result {
 		data.Zones = append(data.Zones, FirewallZoneSummary{
 			ID:   types.StringValue(z.ID),
 			Name: types.StringValue(z.Name),
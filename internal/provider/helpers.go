@@ -0,0 +1,72 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+// listPageSize is the number of items requested per page by listAllPages.
+const listPageSize = 200
+
+// defaultResourceTimeout bounds a create, update or delete when the resource's
+// timeouts block does not set one. It leaves room for the retries of every call.
+const defaultResourceTimeout = 5 * time.Minute
+
+// stringValueOrNull maps an empty string returned by the API to a null value,
+// so that unset optional attributes do not show up as "" in state.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// isNotFound reports whether err is the client's error for a 404 response. The
+// client does not expose the status code, so it is matched in the error message.
+func isNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "status=404")
+}
+
+// importStateSiteScopedID imports a resource from a `site_id/id` identifier,
+// setting both attributes so that site_id is populated after import.
+func importStateSiteScopedID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site_id/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// listAllPages calls list with increasing offsets until every item counted in the
+// response's totalCount has been fetched, and returns the items of all pages.
+func listAllPages[T any](list func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[T], error)) ([]T, error) {
+	var items []T
+	page := &networktypes.PaginationParams{Limit: listPageSize}
+	for {
+		result, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Data...)
+		if len(result.Data) == 0 || len(items) >= result.TotalCount {
+			return items, nil
+		}
+		page = &networktypes.PaginationParams{Offset: page.Offset + len(result.Data), Limit: listPageSize}
+	}
+}
@@ -35,6 +35,9 @@ type NetworkDataSourceModel struct {
 	Default               types.Bool   `tfsdk:"default"`
 	IsolationEnabled      types.Bool   `tfsdk:"isolation_enabled"`
 	InternetAccessEnabled types.Bool   `tfsdk:"internet_access_enabled"`
+	IPv4Configuration     types.Object `tfsdk:"ipv4_configuration"`
+	ResolveAssociations   types.Bool   `tfsdk:"resolve_associations"`
+	AssociatedWifiIDs     types.List   `tfsdk:"associated_wifi_ids"`
 }
 
 func (d *NetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -81,6 +84,164 @@ func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Whether internet access is enabled.",
 				Computed:            true,
 			},
+			"ipv4_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "IPv4 configuration of the network, including its DHCP settings.",
+				Computed:            true,
+				Attributes:          getIPv4ConfigDataSourceSchemaAttributes(),
+			},
+			"resolve_associations": schema.BoolAttribute{
+				MarkdownDescription: "Whether to look up the WiFi broadcasts that use this network and report them in `associated_wifi_ids`. This lists every WiFi broadcast in the site. Defaults to `false`.",
+				Optional:            true,
+			},
+			"associated_wifi_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the WiFi broadcasts that use this network. Null unless `resolve_associations` is `true`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func getIPv4ConfigDataSourceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auto_scale_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether auto-scaling is enabled.",
+			Computed:            true,
+		},
+		"host_ip_address": schema.StringAttribute{
+			MarkdownDescription: "The host IP address (gateway). For auto-scaled networks this is the address the controller assigned.",
+			Computed:            true,
+		},
+		"prefix_length": schema.Int64Attribute{
+			MarkdownDescription: "The prefix length (subnet mask).",
+			Computed:            true,
+		},
+		"additional_host_ip_subnets": schema.SetAttribute{
+			MarkdownDescription: "Additional host IPv4 subnets in CIDR notation.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"dhcp_configuration": schema.SingleNestedAttribute{
+			MarkdownDescription: "DHCP configuration.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					MarkdownDescription: "DHCP mode (dhcp-server, dhcp-relay, none).",
+					Computed:            true,
+				},
+				"ip_address_range": schema.SingleNestedAttribute{
+					MarkdownDescription: "DHCP IP address range.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							MarkdownDescription: "Start IP address.",
+							Computed:            true,
+						},
+						"stop": schema.StringAttribute{
+							MarkdownDescription: "Stop IP address.",
+							Computed:            true,
+						},
+					},
+				},
+				"gateway_ip_address_override": schema.StringAttribute{
+					MarkdownDescription: "Gateway IP address override.",
+					Computed:            true,
+				},
+				"dns_server_ip_addresses_override": schema.ListAttribute{
+					MarkdownDescription: "DNS server IP addresses override, in order of precedence.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"lease_time_seconds": schema.Int64Attribute{
+					MarkdownDescription: "DHCP lease time in seconds.",
+					Computed:            true,
+				},
+				"domain_name": schema.StringAttribute{
+					MarkdownDescription: "Domain name for DHCP clients.",
+					Computed:            true,
+				},
+				"ping_conflict_detection_enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether ping conflict detection is enabled.",
+					Computed:            true,
+				},
+				"pxe_configuration": schema.SingleNestedAttribute{
+					MarkdownDescription: "PXE network boot configuration, sent in the fixed BOOTP fields of the DHCP reply.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"server_ip_address": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address of the boot server, sent as the next-server (`siaddr`) field.",
+							Computed:            true,
+						},
+						"filename": schema.StringAttribute{
+							MarkdownDescription: "The boot filename, sent in the `file` field and as DHCP option 67.",
+							Computed:            true,
+						},
+					},
+				},
+				"ntp_server_ip_addresses": schema.ListAttribute{
+					MarkdownDescription: "NTP server IP addresses.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"option43_value": schema.StringAttribute{
+					MarkdownDescription: "DHCP option 43 value.",
+					Computed:            true,
+				},
+				"tftp_server_address": schema.StringAttribute{
+					MarkdownDescription: "The TFTP server name, sent as DHCP option 66. It may be a hostname or an IP address, and is independent of `pxe_configuration`. Some PXE clients prefer option 66 over the next-server address when both are set.",
+					Computed:            true,
+				},
+				"time_offset_seconds": schema.Int64Attribute{
+					MarkdownDescription: "Time offset in seconds.",
+					Computed:            true,
+				},
+				"wpad_url": schema.StringAttribute{
+					MarkdownDescription: "WPAD URL.",
+					Computed:            true,
+				},
+				"wins_server_ip_addresses": schema.ListAttribute{
+					MarkdownDescription: "WINS server IP addresses.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"dhcp_server_ip_addresses": schema.ListAttribute{
+					MarkdownDescription: "DHCP server IP addresses (for relay mode).",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+		"nat_outbound_ip_address_configuration": schema.ListNestedAttribute{
+			MarkdownDescription: "NAT outbound IP address configuration.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "NAT type.",
+						Computed:            true,
+					},
+					"wan_interface_id": schema.StringAttribute{
+						MarkdownDescription: "WAN interface ID.",
+						Computed:            true,
+					},
+					"ip_address_selectors": schema.ListNestedAttribute{
+						MarkdownDescription: "IP address selectors.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Selector type.",
+									Computed:            true,
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Selector value.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -142,5 +303,17 @@ func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.InternetAccessEnabled = types.BoolNull()
 	}
 
+	// Reuse the resource mapping so the nested object matches unifi_network.ipv4_configuration.
+	var mapper NetworkResource
+	if networkResp.IPv4Configuration != nil {
+		data.IPv4Configuration = mapper.mapIPv4ConfigurationToObject(ctx, networkResp.IPv4Configuration, &resp.Diagnostics)
+	} else {
+		data.IPv4Configuration = types.ObjectNull(getIPv4ConfigAttrTypes())
+	}
+	data.AssociatedWifiIDs = resolveAssociatedWifiIDs(ctx, d.client, data.SiteID.ValueString(), data.ID.ValueString(), data.ResolveAssociations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,17 +6,28 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,6 +37,9 @@ import (
 
 var _ resource.Resource = &NetworkResource{}
 var _ resource.ResourceWithImportState = &NetworkResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkResource{}
+var _ resource.ResourceWithConfigValidators = &NetworkResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkResource{}
 
 func NewNetworkResource() resource.Resource {
 	return &NetworkResource{}
@@ -78,7 +92,7 @@ type NetworkIPv4ConfigurationModel struct {
 	AutoScaleEnabled                  types.Bool   `tfsdk:"auto_scale_enabled"`
 	HostIPAddress                     types.String `tfsdk:"host_ip_address"`
 	PrefixLength                      types.Int64  `tfsdk:"prefix_length"`
-	AdditionalHostIPSubnets           types.List   `tfsdk:"additional_host_ip_subnets"`
+	AdditionalHostIPSubnets           types.Set    `tfsdk:"additional_host_ip_subnets"`
 	DHCPConfiguration                 types.Object `tfsdk:"dhcp_configuration"`
 	NatOutboundIPAddressConfiguration types.List   `tfsdk:"nat_outbound_ip_address_configuration"`
 }
@@ -107,7 +121,7 @@ type NetworkIPv6ConfigurationModel struct {
 	ClientAddressAssignment        types.Object `tfsdk:"client_address_assignment"`
 	RouterAdvertisement            types.Object `tfsdk:"router_advertisement"`
 	DNSServerIPAddressesOverride   types.List   `tfsdk:"dns_server_ip_addresses_override"`
-	AdditionalHostIPSubnets        types.List   `tfsdk:"additional_host_ip_subnets"`
+	AdditionalHostIPSubnets        types.Set    `tfsdk:"additional_host_ip_subnets"`
 	PrefixDelegationWanInterfaceID types.String `tfsdk:"prefix_delegation_wan_interface_id"`
 	HostIPAddress                  types.String `tfsdk:"host_ip_address"`
 	PrefixLength                   types.String `tfsdk:"prefix_length"`
@@ -118,23 +132,36 @@ type DHCPGuardingModel struct {
 }
 
 type NetworkResourceModel struct {
-	SiteID                types.String `tfsdk:"site_id"`
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Enabled               types.Bool   `tfsdk:"enabled"`
-	VlanID                types.Int64  `tfsdk:"vlan_id"`
-	Management            types.String `tfsdk:"management"`
-	IsolationEnabled      types.Bool   `tfsdk:"isolation_enabled"`
-	InternetAccessEnabled types.Bool   `tfsdk:"internet_access_enabled"`
-	MdnsForwardingEnabled types.Bool   `tfsdk:"mdns_forwarding_enabled"`
-	CellularBackupEnabled types.Bool   `tfsdk:"cellular_backup_enabled"`
-	DeviceID              types.String `tfsdk:"device_id"`
-	ZoneID                types.String `tfsdk:"zone_id"`
-	DHCPGuarding          types.Object `tfsdk:"dhcp_guarding"`
-	IPv4Configuration     types.Object `tfsdk:"ipv4_configuration"`
-	IPv6Configuration     types.Object `tfsdk:"ipv6_configuration"`
+	SiteID                types.String   `tfsdk:"site_id"`
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	Enabled               types.Bool     `tfsdk:"enabled"`
+	VlanID                types.Int64    `tfsdk:"vlan_id"`
+	Management            types.String   `tfsdk:"management"`
+	IsolationEnabled      types.Bool     `tfsdk:"isolation_enabled"`
+	InternetAccessEnabled types.Bool     `tfsdk:"internet_access_enabled"`
+	MdnsForwardingEnabled types.Bool     `tfsdk:"mdns_forwarding_enabled"`
+	CellularBackupEnabled types.Bool     `tfsdk:"cellular_backup_enabled"`
+	DeviceID              types.String   `tfsdk:"device_id"`
+	ZoneID                types.String   `tfsdk:"zone_id"`
+	Default               types.Bool     `tfsdk:"default"`
+	DHCPGuarding          types.Object   `tfsdk:"dhcp_guarding"`
+	IPv4Configuration     types.Object   `tfsdk:"ipv4_configuration"`
+	IPv6Configuration     types.Object   `tfsdk:"ipv6_configuration"`
+	ResolveAssociations   types.Bool     `tfsdk:"resolve_associations"`
+	AssociatedWifiIDs     types.List     `tfsdk:"associated_wifi_ids"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
+// minVlanID and maxVlanID bound the usable 802.1Q VLAN IDs; 0 and 4095 are reserved.
+const (
+	minVlanID = 1
+	maxVlanID = 4094
+)
+
+// supportedNetworkManagementTypes lists the management types accepted by the controller.
+var supportedNetworkManagementTypes = []string{"third-party", "gateway", "switch"}
+
 func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_network"
 }
@@ -168,16 +195,22 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(true),
 			},
 			"vlan_id": schema.Int64Attribute{
-				MarkdownDescription: "The VLAN ID of the network. Defaults to `1`.",
+				MarkdownDescription: "The VLAN ID of the network (1-4094). Defaults to `1`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(minVlanID, maxVlanID),
+				},
 			},
 			"management": schema.StringAttribute{
-				MarkdownDescription: "The management type of the network. Defaults to `third-party`.",
+				MarkdownDescription: "The management type of the network (third-party, gateway, switch). Defaults to `third-party`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("third-party"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(supportedNetworkManagementTypes...),
+				},
 			},
 			"isolation_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether network isolation is enabled. Defaults to `false`.",
@@ -208,17 +241,30 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"zone_id": schema.StringAttribute{
-				MarkdownDescription: "The firewall zone ID for this network.",
-				Optional:            true,
+				MarkdownDescription: "The firewall zone ID for this network. Zone membership is also controlled by `unifi_firewall_zone.network_ids`; " +
+					"manage it from only one of the two places. When unset, the zone assigned by the controller is tracked without being changed.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the site's default network. The default network cannot be disabled.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"dhcp_guarding": schema.SingleNestedAttribute{
 				MarkdownDescription: "DHCP guarding configuration.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"trusted_dhcp_server_ip_addresses": schema.ListAttribute{
-						MarkdownDescription: "List of trusted DHCP server IP addresses.",
+						MarkdownDescription: "List of trusted DHCP server IPv4 addresses.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(ipv4AddressValidator{}),
+						},
 					},
 				},
 			},
@@ -231,48 +277,69 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Optional:            true,
 					},
 					"host_ip_address": schema.StringAttribute{
-						MarkdownDescription: "The host IP address (gateway).",
+						MarkdownDescription: "The host IP address (gateway). Assigned by the controller when `auto_scale_enabled` is true, in which case it must not be set.",
 						Optional:            true,
+						Computed:            true,
+						Validators:          []validator.String{ipv4AddressValidator{}},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"prefix_length": schema.Int64Attribute{
-						MarkdownDescription: "The prefix length (subnet mask).",
+						MarkdownDescription: "The prefix length (subnet mask). Assigned by the controller when `auto_scale_enabled` is true, in which case it must not be set.",
 						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
 					},
-					"additional_host_ip_subnets": schema.ListAttribute{
-						MarkdownDescription: "Additional host IP subnets.",
+					"additional_host_ip_subnets": schema.SetAttribute{
+						MarkdownDescription: "Additional host IPv4 subnets in CIDR notation. Must not overlap the primary subnet.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(cidrValidator{}),
+						},
 					},
 					"dhcp_configuration": schema.SingleNestedAttribute{
 						MarkdownDescription: "DHCP configuration.",
 						Optional:            true,
 						Attributes: map[string]schema.Attribute{
 							"mode": schema.StringAttribute{
-								MarkdownDescription: "DHCP mode (dhcp-server, dhcp-relay, none).",
+								MarkdownDescription: "DHCP mode (dhcp-server, dhcp-relay, none). `dhcp-relay` requires `dhcp_server_ip_addresses`.",
 								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf("dhcp-server", "dhcp-relay", "none"),
+								},
 							},
 							"ip_address_range": schema.SingleNestedAttribute{
 								MarkdownDescription: "DHCP IP address range.",
 								Optional:            true,
 								Attributes: map[string]schema.Attribute{
 									"start": schema.StringAttribute{
-										MarkdownDescription: "Start IP address.",
+										MarkdownDescription: "Start IP address. Must be within the network's subnet.",
 										Optional:            true,
+										Validators:          []validator.String{ipv4AddressValidator{}},
 									},
 									"stop": schema.StringAttribute{
-										MarkdownDescription: "Stop IP address.",
+										MarkdownDescription: "Stop IP address. Must be within the network's subnet.",
 										Optional:            true,
+										Validators:          []validator.String{ipv4AddressValidator{}},
 									},
 								},
 							},
 							"gateway_ip_address_override": schema.StringAttribute{
-								MarkdownDescription: "Gateway IP address override.",
+								MarkdownDescription: "Gateway IP address override. Must be an IPv4 address within the network's subnet.",
 								Optional:            true,
+								Validators:          []validator.String{ipv4AddressValidator{}},
 							},
 							"dns_server_ip_addresses_override": schema.ListAttribute{
-								MarkdownDescription: "DNS server IP addresses override.",
+								MarkdownDescription: "DNS server IP addresses override, in order of precedence. The order is preserved as given; if the controller returns the servers in a different order it shows up as a diff.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(ipv4AddressValidator{}),
+								},
 							},
 							"lease_time_seconds": schema.Int64Attribute{
 								MarkdownDescription: "DHCP lease time in seconds.",
@@ -287,15 +354,16 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 								Optional:            true,
 							},
 							"pxe_configuration": schema.SingleNestedAttribute{
-								MarkdownDescription: "PXE boot configuration.",
+								MarkdownDescription: "PXE network boot configuration, sent in the fixed BOOTP fields of the DHCP reply.",
 								Optional:            true,
 								Attributes: map[string]schema.Attribute{
 									"server_ip_address": schema.StringAttribute{
-										MarkdownDescription: "PXE server IP address.",
+										MarkdownDescription: "The IPv4 address of the boot server, sent as the next-server (`siaddr`) field.",
 										Required:            true,
+										Validators:          []validator.String{ipv4AddressValidator{}},
 									},
 									"filename": schema.StringAttribute{
-										MarkdownDescription: "PXE boot filename.",
+										MarkdownDescription: "The boot filename, sent in the `file` field and as DHCP option 67.",
 										Required:            true,
 									},
 								},
@@ -304,13 +372,16 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 								MarkdownDescription: "NTP server IP addresses.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(ipv4AddressValidator{}),
+								},
 							},
 							"option43_value": schema.StringAttribute{
 								MarkdownDescription: "DHCP option 43 value.",
 								Optional:            true,
 							},
 							"tftp_server_address": schema.StringAttribute{
-								MarkdownDescription: "TFTP server address.",
+								MarkdownDescription: "The TFTP server name, sent as DHCP option 66. It may be a hostname or an IP address, and is independent of `pxe_configuration`. Some PXE clients prefer option 66 over the next-server address when both are set.",
 								Optional:            true,
 							},
 							"time_offset_seconds": schema.Int64Attribute{
@@ -325,11 +396,17 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 								MarkdownDescription: "WINS server IP addresses.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(ipv4AddressValidator{}),
+								},
 							},
 							"dhcp_server_ip_addresses": schema.ListAttribute{
 								MarkdownDescription: "DHCP server IP addresses (for relay mode).",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(ipv4AddressValidator{}),
+								},
 							},
 						},
 					},
@@ -384,22 +461,28 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 								Optional:            true,
 								Attributes: map[string]schema.Attribute{
 									"ip_address_suffix_range": schema.SingleNestedAttribute{
-										MarkdownDescription: "IPv6 address suffix range.",
+										MarkdownDescription: "IPv6 address suffix range. `start` must not be greater than `stop`.",
 										Optional:            true,
+										Validators:          []validator.Object{ipv6RangeOrderValidator{}},
 										Attributes: map[string]schema.Attribute{
 											"start": schema.StringAttribute{
-												MarkdownDescription: "Start suffix.",
+												MarkdownDescription: "Start suffix (e.g. `::2`).",
 												Optional:            true,
+												Validators:          []validator.String{ipv6AddressValidator{}},
 											},
 											"stop": schema.StringAttribute{
-												MarkdownDescription: "Stop suffix.",
+												MarkdownDescription: "Stop suffix (e.g. `::7d1`).",
 												Optional:            true,
+												Validators:          []validator.String{ipv6AddressValidator{}},
 											},
 										},
 									},
 									"lease_time_seconds": schema.Int64Attribute{
-										MarkdownDescription: "DHCPv6 lease time in seconds.",
+										MarkdownDescription: "DHCPv6 lease time in seconds (60-31536000).",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.Between(minDHCPLeaseTimeSeconds, maxDHCPLeaseTimeSeconds),
+										},
 									},
 								},
 							},
@@ -420,14 +503,20 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 						},
 					},
 					"dns_server_ip_addresses_override": schema.ListAttribute{
-						MarkdownDescription: "DNS server IPv6 addresses override.",
+						MarkdownDescription: "DNS server IPv6 addresses override, in order of precedence. The order is preserved as given; if the controller returns the servers in a different order it shows up as a diff.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(ipv6AddressValidator{}),
+						},
 					},
-					"additional_host_ip_subnets": schema.ListAttribute{
-						MarkdownDescription: "Additional host IPv6 subnets.",
+					"additional_host_ip_subnets": schema.SetAttribute{
+						MarkdownDescription: "Additional host IPv6 subnets in CIDR notation. Must not overlap the primary subnet.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(cidrValidator{ipv6: true}),
+						},
 					},
 					"prefix_delegation_wan_interface_id": schema.StringAttribute{
 						MarkdownDescription: "WAN interface ID for prefix delegation.",
@@ -436,6 +525,7 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"host_ip_address": schema.StringAttribute{
 						MarkdownDescription: "Host IPv6 address.",
 						Optional:            true,
+						Validators:          []validator.String{ipv6AddressValidator{}},
 					},
 					"prefix_length": schema.StringAttribute{
 						MarkdownDescription: "IPv6 prefix length.",
@@ -443,6 +533,22 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"resolve_associations": schema.BoolAttribute{
+				MarkdownDescription: "Whether to look up the WiFi broadcasts that use this network and report them in `associated_wifi_ids`. This lists every WiFi broadcast in the site on each read. Defaults to `false`.",
+				Optional:            true,
+			},
+			"associated_wifi_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the WiFi broadcasts that use this network. Null unless `resolve_associations` is `true`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -472,6 +578,14 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating UniFi network", map[string]interface{}{
 		"site_id": data.SiteID.ValueString(),
 		"name":    data.Name.ValueString(),
@@ -487,8 +601,19 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create network: %s", err))
 		return
 	}
+	// The ID is used as-is for every later read, so an empty one would leave an
+	// untracked network behind once the first refresh drops it from state.
+	if networkResp.ID == "" {
+		resp.Diagnostics.AddError("Client Error", "Unable to create network: the controller response did not include a network ID")
+		return
+	}
 
 	data.ID = types.StringValue(networkResp.ID)
+	r.setComputedValues(ctx, networkResp, &data, &resp.Diagnostics)
+	data.AssociatedWifiIDs = resolveAssociatedWifiIDs(ctx, r.client, data.SiteID.ValueString(), data.ID.ValueString(), data.ResolveAssociations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Created UniFi network", map[string]interface{}{
 		"id": networkResp.ID,
@@ -515,11 +640,19 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		NetworkID: data.ID.ValueString(),
 	})
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The network no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network: %s", err))
 		return
 	}
 
 	r.mapResponseToModel(ctx, networkResp, &data, &resp.Diagnostics)
+	data.AssociatedWifiIDs = resolveAssociatedWifiIDs(ctx, r.client, data.SiteID.ValueString(), data.ID.ValueString(), data.ResolveAssociations, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -535,6 +668,14 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Updating UniFi network", map[string]interface{}{
 		"site_id":    data.SiteID.ValueString(),
 		"network_id": data.ID.ValueString(),
@@ -545,15 +686,139 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	_, err := r.client.UpdateNetwork(ctx, updateReq)
+	networkResp, err := r.client.UpdateNetwork(ctx, updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update network: %s", err))
 		return
 	}
 
+	r.verifyIsolationEnabled(ctx, data.SiteID.ValueString(), networkResp, data.IsolationEnabled, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setComputedValues(ctx, networkResp, &data, &resp.Diagnostics)
+	data.AssociatedWifiIDs = resolveAssociatedWifiIDs(ctx, r.client, data.SiteID.ValueString(), data.ID.ValueString(), data.ResolveAssociations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// resolveAssociatedWifiIDs returns the IDs of the WiFi broadcasts in the site that reference
+// the network, or null without calling the API when resolve is not true.
+func resolveAssociatedWifiIDs(ctx context.Context, client *network.Client, siteID, networkID string, resolve types.Bool, diags *diag.Diagnostics) types.List {
+	if !resolve.ValueBool() {
+		return types.ListNull(types.StringType)
+	}
+
+	broadcasts, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.WifiBroadcast], error) {
+		return client.ListWifiBroadcasts(ctx, networktypes.ListWifiBroadcastsRequest{
+			SiteID:     siteID,
+			Pagination: page,
+		})
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read WiFi broadcasts: %s", err))
+		return types.ListNull(types.StringType)
+	}
+
+	wifiIDs := []string{}
+	for _, b := range broadcasts {
+		if b.Network != nil && b.Network.NetworkID == networkID {
+			wifiIDs = append(wifiIDs, b.ID)
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, wifiIDs)
+	diags.Append(d...)
+	return list
+}
+
+// verifyIsolationEnabled checks that the controller applied the planned isolation_enabled
+// value. The network is read back when the update response does not include it.
+func (r *NetworkResource) verifyIsolationEnabled(ctx context.Context, siteID string, networkResp *networktypes.Network, planned types.Bool, diags *diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	actual := networkResp.IsolationEnabled
+	if actual == nil {
+		details, err := r.client.GetNetworkDetails(ctx, networktypes.GetNetworkDetailsRequest{
+			SiteID:    siteID,
+			NetworkID: networkResp.ID,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read network after update: %s", err))
+			return
+		}
+		actual = details.IsolationEnabled
+	}
+
+	if actual == nil || *actual != planned.ValueBool() {
+		reported := "not reported"
+		if actual != nil {
+			reported = fmt.Sprintf("%t", *actual)
+		}
+		diags.AddAttributeError(
+			path.Root("isolation_enabled"),
+			"Network Isolation Not Applied",
+			fmt.Sprintf("isolation_enabled was set to %t, but the controller reports %s after the update.", planned.ValueBool(), reported),
+		)
+	}
+}
+
+// setComputedValues fills in the attributes the plan left unknown from a create or update
+// response. Every other attribute keeps its planned value, since Terraform rejects an apply
+// result that differs from a known planned value; any normalization by the controller shows
+// up on the next refresh instead.
+func (r *NetworkResource) setComputedValues(ctx context.Context, networkResp *networktypes.Network, data *NetworkResourceModel, diags *diag.Diagnostics) {
+	var respData NetworkResourceModel
+	r.mapResponseToModel(ctx, networkResp, &respData, diags)
+
+	if data.ZoneID.IsUnknown() {
+		data.ZoneID = respData.ZoneID
+	}
+	if data.Default.IsUnknown() {
+		data.Default = respData.Default
+	}
+	r.setComputedIPv4Values(ctx, networkResp, data, diags)
+}
+
+// setComputedIPv4Values fills in the IPv4 attributes the controller assigns when auto-scale is enabled.
+func (r *NetworkResource) setComputedIPv4Values(ctx context.Context, networkResp *networktypes.Network, data *NetworkResourceModel, diags *diag.Diagnostics) {
+	if data.IPv4Configuration.IsNull() || data.IPv4Configuration.IsUnknown() {
+		return
+	}
+
+	var ipv4Config NetworkIPv4ConfigurationModel
+	diags.Append(data.IPv4Configuration.As(ctx, &ipv4Config, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	var respIPv4 networktypes.NetworkIPv4Configuration
+	if networkResp.IPv4Configuration != nil {
+		respIPv4 = *networkResp.IPv4Configuration
+	}
+
+	if ipv4Config.HostIPAddress.IsUnknown() {
+		ipv4Config.HostIPAddress = stringValueOrNull(respIPv4.HostIPAddress)
+	}
+	if ipv4Config.PrefixLength.IsUnknown() {
+		if respIPv4.PrefixLength != nil {
+			ipv4Config.PrefixLength = types.Int64Value(int64(*respIPv4.PrefixLength))
+		} else {
+			ipv4Config.PrefixLength = types.Int64Null()
+		}
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, getIPv4ConfigAttrTypes(), ipv4Config)
+	diags.Append(d...)
+	data.IPv4Configuration = obj
+}
+
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data NetworkResourceModel
 
@@ -562,6 +827,14 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Deleting UniFi network", map[string]interface{}{
 		"site_id":    data.SiteID.ValueString(),
 		"network_id": data.ID.ValueString(),
@@ -577,8 +850,274 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// ModifyPlan rejects disabling the default network. This needs the default flag from
+// state, which ValidateConfig does not have access to.
+func (r *NetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var isDefault, enabled types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("default"), &isDefault)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enabled"), &enabled)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isDefault.ValueBool() && !enabled.IsUnknown() && !enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Cannot Disable Default Network",
+			"This is the site's default network, which the controller does not allow to be disabled. Remove enabled = false from its configuration.",
+		)
+	}
+}
+
+func (r *NetworkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		networkDHCPModeValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = networkDHCPModeValidator{}
+
+// networkDHCPModeValidator checks that the DHCP configuration matches its mode: a relay
+// needs at least one server to relay to, and a network without DHCP has no address range.
+type networkDHCPModeValidator struct{}
+
+func (v networkDHCPModeValidator) Description(ctx context.Context) string {
+	return "Checks that the DHCP configuration attributes set match the DHCP mode."
+}
+
+func (v networkDHCPModeValidator) MarkdownDescription(ctx context.Context) string {
+	return "Checks that the DHCP configuration attributes set match the DHCP `mode`."
+}
+
+func (v networkDHCPModeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	dhcpPath := path.Root("ipv4_configuration").AtName("dhcp_configuration")
+
+	var dhcpObj types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, dhcpPath, &dhcpObj)...)
+	if resp.Diagnostics.HasError() || dhcpObj.IsNull() || dhcpObj.IsUnknown() {
+		return
+	}
+
+	var dhcpConfig NetworkDHCPConfigurationModel
+	resp.Diagnostics.Append(dhcpObj.As(ctx, &dhcpConfig, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if resp.Diagnostics.HasError() || dhcpConfig.Mode.IsNull() || dhcpConfig.Mode.IsUnknown() {
+		return
+	}
+
+	switch dhcpConfig.Mode.ValueString() {
+	case "dhcp-relay":
+		if dhcpConfig.DHCPServerIPAddresses.IsNull() ||
+			(!dhcpConfig.DHCPServerIPAddresses.IsUnknown() && len(dhcpConfig.DHCPServerIPAddresses.Elements()) == 0) {
+			resp.Diagnostics.AddAttributeError(
+				dhcpPath.AtName("dhcp_server_ip_addresses"),
+				"Missing DHCP Relay Servers",
+				"dhcp_server_ip_addresses must list at least one server when mode is dhcp-relay.",
+			)
+		}
+	case "none":
+		if !dhcpConfig.IPAddressRange.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				dhcpPath.AtName("ip_address_range"),
+				"Conflicting DHCP Configuration",
+				"ip_address_range must not be set when mode is none.",
+			)
+		}
+	}
+}
+
+func (r *NetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NetworkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ZoneID.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("zone_id"),
+			"Zone Membership Set From Network",
+			"Setting zone_id moves this network into the firewall zone, which also changes the zone's network_ids. "+
+				"If a unifi_firewall_zone resource manages that zone, list this network in its network_ids as well, "+
+				"or leave zone_id unset and manage the membership there only, so the two resources do not keep overwriting each other.",
+		)
+	}
+
+	if !data.IPv4Configuration.IsNull() && !data.IPv4Configuration.IsUnknown() {
+		r.validateIPv4Configuration(ctx, data.IPv4Configuration, &resp.Diagnostics)
+		if data.IsolationEnabled.ValueBool() && ipv4DHCPServerEnabled(ctx, data.IPv4Configuration) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("isolation_enabled"),
+				"Isolated Network Still Reaches Gateway",
+				"Isolation blocks traffic to other networks, but clients on a network served by the gateway's DHCP server "+
+					"can still reach the gateway itself for DHCP, DNS and any other services it exposes on this network. "+
+					"Add firewall policies if gateway access must also be restricted.",
+			)
+		}
+
+		var ipv4Config NetworkIPv4ConfigurationModel
+		resp.Diagnostics.Append(data.IPv4Configuration.As(ctx, &ipv4Config, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if !ipv4Config.PrefixLength.IsNull() && !ipv4Config.PrefixLength.IsUnknown() {
+			validateAdditionalHostIPSubnets(path.Root("ipv4_configuration"), ipv4Config.HostIPAddress, int(ipv4Config.PrefixLength.ValueInt64()), ipv4Config.AdditionalHostIPSubnets, &resp.Diagnostics)
+		}
+	}
+
+	if !data.IPv6Configuration.IsNull() && !data.IPv6Configuration.IsUnknown() {
+		var ipv6Config NetworkIPv6ConfigurationModel
+		resp.Diagnostics.Append(data.IPv6Configuration.As(ctx, &ipv6Config, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if prefixLength, err := strconv.Atoi(ipv6Config.PrefixLength.ValueString()); !ipv6Config.PrefixLength.IsUnknown() && err == nil {
+			validateAdditionalHostIPSubnets(path.Root("ipv6_configuration"), ipv6Config.HostIPAddress, prefixLength, ipv6Config.AdditionalHostIPSubnets, &resp.Diagnostics)
+		}
+	}
+}
+
+// validateAdditionalHostIPSubnets reports additional host subnets that overlap the primary
+// subnet given by hostIP and prefixLength. Malformed values are reported by the attribute validators.
+func validateAdditionalHostIPSubnets(configPath path.Path, hostIP types.String, prefixLength int, subnets types.Set, diags *diag.Diagnostics) {
+	if hostIP.IsNull() || hostIP.IsUnknown() || subnets.IsNull() || subnets.IsUnknown() {
+		return
+	}
+
+	hostAddr, err := netip.ParseAddr(hostIP.ValueString())
+	if err != nil {
+		return
+	}
+	primary, err := hostAddr.Prefix(prefixLength)
+	if err != nil {
+		return
+	}
+
+	for _, elem := range subnets.Elements() {
+		value, ok := elem.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		subnet, err := netip.ParsePrefix(value.ValueString())
+		if err != nil {
+			continue
+		}
+		if subnet.Overlaps(primary) {
+			diags.AddAttributeError(
+				configPath.AtName("additional_host_ip_subnets").AtSetValue(value),
+				"Overlapping Additional Subnet",
+				fmt.Sprintf("Additional subnet %s overlaps the primary subnet %s.", value.ValueString(), primary),
+			)
+		}
+	}
+}
+
+// ipv4DHCPServerEnabled reports whether the IPv4 configuration uses the gateway as DHCP server.
+func ipv4DHCPServerEnabled(ctx context.Context, ipv4Obj types.Object) bool {
+	var ipv4Config NetworkIPv4ConfigurationModel
+	if diags := ipv4Obj.As(ctx, &ipv4Config, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return false
+	}
+	if ipv4Config.DHCPConfiguration.IsNull() || ipv4Config.DHCPConfiguration.IsUnknown() {
+		return false
+	}
+
+	var dhcpConfig NetworkDHCPConfigurationModel
+	if diags := ipv4Config.DHCPConfiguration.As(ctx, &dhcpConfig, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return false
+	}
+	return strings.EqualFold(dhcpConfig.Mode.ValueString(), "dhcp-server")
+}
+
+func (r *NetworkResource) validateIPv4Configuration(ctx context.Context, ipv4Obj types.Object, diags *diag.Diagnostics) {
+	var ipv4Config NetworkIPv4ConfigurationModel
+	diags.Append(ipv4Obj.As(ctx, &ipv4Config, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return
+	}
+
+	if ipv4Config.AutoScaleEnabled.ValueBool() {
+		if !ipv4Config.HostIPAddress.IsNull() {
+			diags.AddAttributeError(
+				path.Root("ipv4_configuration").AtName("host_ip_address"),
+				"Conflicting IPv4 Configuration",
+				"host_ip_address is assigned by the controller when auto_scale_enabled is true and must not be set.",
+			)
+		}
+		if !ipv4Config.PrefixLength.IsNull() {
+			diags.AddAttributeError(
+				path.Root("ipv4_configuration").AtName("prefix_length"),
+				"Conflicting IPv4 Configuration",
+				"prefix_length is assigned by the controller when auto_scale_enabled is true and must not be set.",
+			)
+		}
+	}
+
+	if ipv4Config.DHCPConfiguration.IsNull() || ipv4Config.DHCPConfiguration.IsUnknown() {
+		return
+	}
+
+	var dhcpConfig NetworkDHCPConfigurationModel
+	diags.Append(ipv4Config.DHCPConfiguration.As(ctx, &dhcpConfig, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return
+	}
+
+	if ipv4Config.HostIPAddress.IsNull() || ipv4Config.HostIPAddress.IsUnknown() ||
+		ipv4Config.PrefixLength.IsNull() || ipv4Config.PrefixLength.IsUnknown() {
+		return
+	}
+
+	hostAddr, err := netip.ParseAddr(ipv4Config.HostIPAddress.ValueString())
+	if err != nil {
+		return
+	}
+	subnet, err := hostAddr.Prefix(int(ipv4Config.PrefixLength.ValueInt64()))
+	if err != nil {
+		return
+	}
+
+	if !dhcpConfig.GatewayIPAddressOverride.IsNull() && !dhcpConfig.GatewayIPAddressOverride.IsUnknown() {
+		gateway, err := netip.ParseAddr(dhcpConfig.GatewayIPAddressOverride.ValueString())
+		if err == nil && !subnet.Contains(gateway) {
+			diags.AddAttributeError(
+				path.Root("ipv4_configuration").AtName("dhcp_configuration").AtName("gateway_ip_address_override"),
+				"Invalid Gateway Override",
+				fmt.Sprintf("Gateway %s is not within the network subnet %s.", gateway, subnet),
+			)
+		}
+	}
+
+	if !dhcpConfig.IPAddressRange.IsNull() && !dhcpConfig.IPAddressRange.IsUnknown() {
+		var ipRange NetworkDHCPIPAddressRangeModel
+		diags.Append(dhcpConfig.IPAddressRange.As(ctx, &ipRange, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if diags.HasError() {
+			return
+		}
+
+		rangePath := path.Root("ipv4_configuration").AtName("dhcp_configuration").AtName("ip_address_range")
+		for _, endpoint := range []struct {
+			name  string
+			value types.String
+		}{
+			{"start", ipRange.Start},
+			{"stop", ipRange.Stop},
+		} {
+			if endpoint.value.IsNull() || endpoint.value.IsUnknown() {
+				continue
+			}
+			addr, err := netip.ParseAddr(endpoint.value.ValueString())
+			if err != nil || subnet.Contains(addr) {
+				continue
+			}
+			diags.AddAttributeError(
+				rangePath.AtName(endpoint.name),
+				"DHCP Range Outside Subnet",
+				fmt.Sprintf("DHCP range %s %s is not within the network subnet %s.", endpoint.name, addr, subnet),
+			)
+		}
+	}
+}
+
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
 }
 
 func (r *NetworkResource) buildCreateRequest(ctx context.Context, data *NetworkResourceModel, diags *diag.Diagnostics) networktypes.CreateNetworkRequest {
@@ -684,7 +1223,7 @@ func (r *NetworkResource) buildIPv4Configuration(ctx context.Context, ipv4Obj ty
 		result.AutoScaleEnabled = &autoScale
 	}
 
-	if !ipv4Config.PrefixLength.IsNull() {
+	if !ipv4Config.PrefixLength.IsNull() && !ipv4Config.PrefixLength.IsUnknown() {
 		prefixLen := int(ipv4Config.PrefixLength.ValueInt64())
 		result.PrefixLength = &prefixLen
 	}
@@ -882,8 +1421,9 @@ func (r *NetworkResource) mapResponseToModel(ctx context.Context, resp *networkt
 	data.Enabled = types.BoolValue(resp.Enabled)
 	data.VlanID = types.Int64Value(int64(resp.VlanID))
 	data.Management = types.StringValue(resp.Management)
-	data.DeviceID = types.StringValue(resp.DeviceID)
-	data.ZoneID = types.StringValue(resp.ZoneID)
+	data.DeviceID = stringValueOrNull(resp.DeviceID)
+	data.ZoneID = stringValueOrNull(resp.ZoneID)
+	data.Default = types.BoolValue(resp.Default)
 
 	if resp.IsolationEnabled != nil {
 		data.IsolationEnabled = types.BoolValue(*resp.IsolationEnabled)
@@ -919,17 +1459,8 @@ func (r *NetworkResource) mapResponseToModel(ctx context.Context, resp *networkt
 }
 
 func (r *NetworkResource) mapIPv4ConfigurationToObject(ctx context.Context, ipv4 *networktypes.NetworkIPv4Configuration, diags *diag.Diagnostics) types.Object {
-	attrTypes := map[string]attr.Type{
-		"auto_scale_enabled":                    types.BoolType,
-		"host_ip_address":                       types.StringType,
-		"prefix_length":                         types.Int64Type,
-		"additional_host_ip_subnets":            types.ListType{ElemType: types.StringType},
-		"dhcp_configuration":                    types.ObjectType{AttrTypes: getDHCPConfigAttrTypes()},
-		"nat_outbound_ip_address_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: getNATOutboundAttrTypes()}},
-	}
-
 	attrValues := map[string]attr.Value{
-		"host_ip_address": types.StringValue(ipv4.HostIPAddress),
+		"host_ip_address": stringValueOrNull(ipv4.HostIPAddress),
 	}
 
 	if ipv4.AutoScaleEnabled != nil {
@@ -945,11 +1476,11 @@ func (r *NetworkResource) mapIPv4ConfigurationToObject(ctx context.Context, ipv4
 	}
 
 	if len(ipv4.AdditionalHostIPSubnets) > 0 {
-		subnets, d := types.ListValueFrom(ctx, types.StringType, ipv4.AdditionalHostIPSubnets)
+		subnets, d := types.SetValueFrom(ctx, types.StringType, ipv4.AdditionalHostIPSubnets)
 		diags.Append(d...)
 		attrValues["additional_host_ip_subnets"] = subnets
 	} else {
-		attrValues["additional_host_ip_subnets"] = types.ListNull(types.StringType)
+		attrValues["additional_host_ip_subnets"] = types.SetNull(types.StringType)
 	}
 
 	if ipv4.DHCPConfiguration != nil {
@@ -964,11 +1495,22 @@ func (r *NetworkResource) mapIPv4ConfigurationToObject(ctx context.Context, ipv4
 		attrValues["nat_outbound_ip_address_configuration"] = types.ListNull(types.ObjectType{AttrTypes: getNATOutboundAttrTypes()})
 	}
 
-	obj, d := types.ObjectValue(attrTypes, attrValues)
+	obj, d := types.ObjectValue(getIPv4ConfigAttrTypes(), attrValues)
 	diags.Append(d...)
 	return obj
 }
 
+func getIPv4ConfigAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"auto_scale_enabled":                    types.BoolType,
+		"host_ip_address":                       types.StringType,
+		"prefix_length":                         types.Int64Type,
+		"additional_host_ip_subnets":            types.SetType{ElemType: types.StringType},
+		"dhcp_configuration":                    types.ObjectType{AttrTypes: getDHCPConfigAttrTypes()},
+		"nat_outbound_ip_address_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: getNATOutboundAttrTypes()}},
+	}
+}
+
 func getDHCPConfigAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"mode": types.StringType,
@@ -1009,11 +1551,11 @@ func getNATOutboundAttrTypes() map[string]attr.Type {
 func (r *NetworkResource) mapDHCPConfigToObject(ctx context.Context, dhcp *networktypes.NetworkDHCPConfiguration, diags *diag.Diagnostics) types.Object {
 	attrValues := map[string]attr.Value{
 		"mode":                        types.StringValue(dhcp.Mode),
-		"gateway_ip_address_override": types.StringValue(dhcp.GatewayIPAddressOverride),
-		"domain_name":                 types.StringValue(dhcp.DomainName),
-		"option43_value":              types.StringValue(dhcp.Option43Value),
-		"tftp_server_address":         types.StringValue(dhcp.TftpServerAddress),
-		"wpad_url":                    types.StringValue(dhcp.WpadURL),
+		"gateway_ip_address_override": stringValueOrNull(dhcp.GatewayIPAddressOverride),
+		"domain_name":                 stringValueOrNull(dhcp.DomainName),
+		"option43_value":              stringValueOrNull(dhcp.Option43Value),
+		"tftp_server_address":         stringValueOrNull(dhcp.TftpServerAddress),
+		"wpad_url":                    stringValueOrNull(dhcp.WpadURL),
 	}
 
 	if dhcp.IPAddressRange != nil {
@@ -1146,7 +1688,7 @@ func (r *NetworkResource) mapIPv6ConfigurationToObject(ctx context.Context, ipv6
 			"priority": types.StringType,
 		}},
 		"dns_server_ip_addresses_override":   types.ListType{ElemType: types.StringType},
-		"additional_host_ip_subnets":         types.ListType{ElemType: types.StringType},
+		"additional_host_ip_subnets":         types.SetType{ElemType: types.StringType},
 		"prefix_delegation_wan_interface_id": types.StringType,
 		"host_ip_address":                    types.StringType,
 		"prefix_length":                      types.StringType,
@@ -1154,9 +1696,9 @@ func (r *NetworkResource) mapIPv6ConfigurationToObject(ctx context.Context, ipv6
 
 	attrValues := map[string]attr.Value{
 		"interface_type":                     types.StringValue(ipv6.InterfaceType),
-		"prefix_delegation_wan_interface_id": types.StringValue(ipv6.PrefixDelegationWanInterfaceID),
-		"host_ip_address":                    types.StringValue(ipv6.HostIPAddress),
-		"prefix_length":                      types.StringValue(ipv6.PrefixLength),
+		"prefix_delegation_wan_interface_id": stringValueOrNull(ipv6.PrefixDelegationWanInterfaceID),
+		"host_ip_address":                    stringValueOrNull(ipv6.HostIPAddress),
+		"prefix_length":                      stringValueOrNull(ipv6.PrefixLength),
 	}
 
 	if ipv6.ClientAddressAssignment != nil {
@@ -1222,7 +1764,7 @@ func (r *NetworkResource) mapIPv6ConfigurationToObject(ctx context.Context, ipv6
 	if ipv6.RouterAdvertisement != nil {
 		raObj, d := types.ObjectValue(
 			map[string]attr.Type{"priority": types.StringType},
-			map[string]attr.Value{"priority": types.StringValue(ipv6.RouterAdvertisement.Priority)},
+			map[string]attr.Value{"priority": stringValueOrNull(ipv6.RouterAdvertisement.Priority)},
 		)
 		diags.Append(d...)
 		attrValues["router_advertisement"] = raObj
@@ -1239,11 +1781,11 @@ func (r *NetworkResource) mapIPv6ConfigurationToObject(ctx context.Context, ipv6
 	}
 
 	if len(ipv6.AdditionalHostIPSubnets) > 0 {
-		subnets, d := types.ListValueFrom(ctx, types.StringType, ipv6.AdditionalHostIPSubnets)
+		subnets, d := types.SetValueFrom(ctx, types.StringType, ipv6.AdditionalHostIPSubnets)
 		diags.Append(d...)
 		attrValues["additional_host_ip_subnets"] = subnets
 	} else {
-		attrValues["additional_host_ip_subnets"] = types.ListNull(types.StringType)
+		attrValues["additional_host_ip_subnets"] = types.SetNull(types.StringType)
 	}
 
 	obj, d := types.ObjectValue(attrTypes, attrValues)
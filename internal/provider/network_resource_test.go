@@ -0,0 +1,486 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+func boolPtr(v bool) *bool { return &v }
+
+func intPtr(v int) *int { return &v }
+
+// TestNetworkResourceRoundTrip maps a network returned by the API into the resource model and
+// builds an update request from it. The request must send back what the API returned, so that
+// applying an unchanged configuration does not modify the network.
+func TestNetworkResourceRoundTrip(t *testing.T) {
+	cases := map[string]networktypes.Network{
+		"minimal": {
+			ID:                    "net-1",
+			Name:                  "LAN",
+			Enabled:               true,
+			VlanID:                1,
+			Management:            "third-party",
+			IsolationEnabled:      boolPtr(false),
+			InternetAccessEnabled: boolPtr(true),
+			MdnsForwardingEnabled: boolPtr(false),
+			CellularBackupEnabled: boolPtr(false),
+		},
+		"full": {
+			ID:                    "net-2",
+			Name:                  "IoT",
+			Enabled:               false,
+			VlanID:                30,
+			Management:            "gateway",
+			IsolationEnabled:      boolPtr(true),
+			InternetAccessEnabled: boolPtr(false),
+			MdnsForwardingEnabled: boolPtr(true),
+			CellularBackupEnabled: boolPtr(true),
+			DeviceID:              "device-1",
+			ZoneID:                "zone-1",
+			DHCPGuarding: &networktypes.DHCPGuarding{
+				TrustedDHCPServerIPAddresses: []string{"192.168.30.1", "192.168.30.2"},
+			},
+			IPv4Configuration: &networktypes.NetworkIPv4Configuration{
+				AutoScaleEnabled:        boolPtr(false),
+				HostIPAddress:           "192.168.30.1",
+				PrefixLength:            intPtr(24),
+				AdditionalHostIPSubnets: []string{"10.30.0.1/24"},
+				DHCPConfiguration: &networktypes.NetworkDHCPConfiguration{
+					Mode: "server",
+					IPAddressRange: &networktypes.NetworkDHCPIPAddressRange{
+						Start: "192.168.30.6",
+						Stop:  "192.168.30.254",
+					},
+					GatewayIPAddressOverride:     "192.168.30.2",
+					DNSServerIPAddressesOverride: []string{"1.1.1.1", "8.8.8.8"},
+					LeaseTimeSeconds:             intPtr(86400),
+					DomainName:                   "iot.example.com",
+					PingConflictDetectionEnabled: boolPtr(true),
+					PxeConfiguration: &networktypes.NetworkPXEConfiguration{
+						ServerIPAddress: "192.168.30.10",
+						Filename:        "pxelinux.0",
+					},
+					NtpServerIPAddresses:  []string{"192.168.30.1"},
+					Option43Value:         "192.168.30.11",
+					TftpServerAddress:     "192.168.30.12",
+					TimeOffsetSeconds:     intPtr(-3600),
+					WpadURL:               "http://wpad.example.com/wpad.dat",
+					WinsServerIPAddresses: []string{"192.168.30.13"},
+				},
+				NatOutboundIPAddressConfiguration: []networktypes.NetworkNATOutboundIPAddressConfig{
+					{
+						Type:           "custom",
+						WanInterfaceID: "wan-1",
+						IpAddressSelectors: []networktypes.IPAddressSelector{
+							{Type: "specific", Value: "203.0.113.10"},
+						},
+					},
+				},
+			},
+			IPv6Configuration: &networktypes.NetworkIPv6Configuration{
+				InterfaceType: "static",
+				ClientAddressAssignment: &networktypes.IPv6ClientAddressAssignment{
+					DHCPConfiguration: &networktypes.IPv6DHCPConfiguration{
+						IPAddressSuffixRange: &networktypes.IPv6AddressSuffixRange{
+							Start: "::2",
+							Stop:  "::7d1",
+						},
+						LeaseTimeSeconds: 86400,
+					},
+					SlaacEnabled: true,
+				},
+				RouterAdvertisement:          &networktypes.IPv6RouterAdvertisement{Priority: "high"},
+				DNSServerIPAddressesOverride: []string{"2606:4700:4700::1111"},
+				AdditionalHostIPSubnets:      []string{"2001:db8:1::1/64"},
+				HostIPAddress:                "2001:db8::1",
+				PrefixLength:                 "64",
+			},
+		},
+		"dhcp relay": {
+			ID:                    "net-3",
+			Name:                  "Relay",
+			Enabled:               true,
+			VlanID:                40,
+			Management:            "gateway",
+			IsolationEnabled:      boolPtr(false),
+			InternetAccessEnabled: boolPtr(true),
+			MdnsForwardingEnabled: boolPtr(false),
+			CellularBackupEnabled: boolPtr(false),
+			IPv4Configuration: &networktypes.NetworkIPv4Configuration{
+				HostIPAddress: "192.168.40.1",
+				PrefixLength:  intPtr(24),
+				DHCPConfiguration: &networktypes.NetworkDHCPConfiguration{
+					Mode:                  "relay",
+					DHCPServerIPAddresses: []string{"10.0.0.5"},
+				},
+			},
+		},
+		"auto-scaled IPv4 without an assigned address": {
+			ID:                    "net-4",
+			Name:                  "Auto",
+			Enabled:               true,
+			VlanID:                50,
+			Management:            "gateway",
+			IsolationEnabled:      boolPtr(false),
+			InternetAccessEnabled: boolPtr(true),
+			MdnsForwardingEnabled: boolPtr(false),
+			CellularBackupEnabled: boolPtr(false),
+			IPv4Configuration: &networktypes.NetworkIPv4Configuration{
+				AutoScaleEnabled: boolPtr(true),
+			},
+		},
+		"empty strings and lists": {
+			ID:                    "net-5",
+			Name:                  "Empty",
+			Enabled:               true,
+			VlanID:                60,
+			Management:            "gateway",
+			IsolationEnabled:      boolPtr(false),
+			InternetAccessEnabled: boolPtr(true),
+			MdnsForwardingEnabled: boolPtr(false),
+			CellularBackupEnabled: boolPtr(false),
+			DeviceID:              "",
+			ZoneID:                "",
+			DHCPGuarding: &networktypes.DHCPGuarding{
+				TrustedDHCPServerIPAddresses: []string{},
+			},
+			IPv4Configuration: &networktypes.NetworkIPv4Configuration{
+				HostIPAddress:                     "192.168.60.1",
+				PrefixLength:                      intPtr(24),
+				AdditionalHostIPSubnets:           []string{},
+				NatOutboundIPAddressConfiguration: []networktypes.NetworkNATOutboundIPAddressConfig{},
+				DHCPConfiguration: &networktypes.NetworkDHCPConfiguration{
+					Mode:                         "server",
+					GatewayIPAddressOverride:     "",
+					DNSServerIPAddressesOverride: []string{},
+					DomainName:                   "",
+					NtpServerIPAddresses:         []string{},
+				},
+			},
+			IPv6Configuration: &networktypes.NetworkIPv6Configuration{
+				InterfaceType:                "prefix_delegation",
+				DNSServerIPAddressesOverride: []string{},
+				AdditionalHostIPSubnets:      []string{},
+			},
+		},
+	}
+
+	for name, network := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &NetworkResource{}
+			var diags diag.Diagnostics
+
+			data := NetworkResourceModel{
+				SiteID: types.StringValue("site-1"),
+				ID:     types.StringValue(network.ID),
+			}
+			r.mapResponseToModel(ctx, &network, &data, &diags)
+			got := r.buildUpdateRequest(ctx, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got.SiteID != "site-1" || got.NetworkID != network.ID {
+				t.Errorf("got site %q and network %q, want %q and %q", got.SiteID, got.NetworkID, "site-1", network.ID)
+			}
+			want := networktypes.UpdateNetworkRequest{
+				Management:            network.Management,
+				Name:                  network.Name,
+				Enabled:               network.Enabled,
+				VlanID:                network.VlanID,
+				DHCPGuarding:          network.DHCPGuarding,
+				IsolationEnabled:      network.IsolationEnabled,
+				CellularBackupEnabled: network.CellularBackupEnabled,
+				DeviceID:              network.DeviceID,
+				ZoneID:                network.ZoneID,
+				InternetAccessEnabled: network.InternetAccessEnabled,
+				MdnsForwardingEnabled: network.MdnsForwardingEnabled,
+				IPv4Configuration:     network.IPv4Configuration,
+				IPv6Configuration:     network.IPv6Configuration,
+			}
+			// Compare the request bodies, since the API treats an omitted list and an
+			// empty one the same.
+			gotJSON, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantJSON, err := json.Marshal(want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("round trip changed the request body\ngot:  %s\nwant: %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+// TestNetworkResourceMapEmptyValues checks that empty strings and lists returned by the API are
+// mapped to null, so that unset optional attributes do not show a diff on the next plan.
+func TestNetworkResourceMapEmptyValues(t *testing.T) {
+	ctx := context.Background()
+	r := &NetworkResource{}
+	var diags diag.Diagnostics
+
+	network := networktypes.Network{
+		ID:         "net-1",
+		Name:       "LAN",
+		Enabled:    true,
+		VlanID:     1,
+		Management: "gateway",
+		IPv4Configuration: &networktypes.NetworkIPv4Configuration{
+			AdditionalHostIPSubnets: []string{},
+			DHCPConfiguration: &networktypes.NetworkDHCPConfiguration{
+				Mode:                         "server",
+				DNSServerIPAddressesOverride: []string{},
+			},
+		},
+	}
+
+	var data NetworkResourceModel
+	r.mapResponseToModel(ctx, &network, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.DeviceID.IsNull() {
+		t.Errorf("device_id = %s, want null", data.DeviceID)
+	}
+	if !data.ZoneID.IsNull() {
+		t.Errorf("zone_id = %s, want null", data.ZoneID)
+	}
+	if !data.IPv6Configuration.IsNull() {
+		t.Errorf("ipv6_configuration = %s, want null", data.IPv6Configuration)
+	}
+
+	var ipv4 NetworkIPv4ConfigurationModel
+	diags.Append(data.IPv4Configuration.As(ctx, &ipv4, basetypes.ObjectAsOptions{})...)
+	var dhcp NetworkDHCPConfigurationModel
+	diags.Append(ipv4.DHCPConfiguration.As(ctx, &dhcp, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	nulls := map[string]interface{ IsNull() bool }{
+		"ipv4_configuration.host_ip_address":                                     ipv4.HostIPAddress,
+		"ipv4_configuration.prefix_length":                                       ipv4.PrefixLength,
+		"ipv4_configuration.auto_scale_enabled":                                  ipv4.AutoScaleEnabled,
+		"ipv4_configuration.additional_host_ip_subnets":                          ipv4.AdditionalHostIPSubnets,
+		"ipv4_configuration.nat_outbound_ip_address_configuration":               ipv4.NatOutboundIPAddressConfiguration,
+		"ipv4_configuration.dhcp_configuration.gateway_ip_address_override":      dhcp.GatewayIPAddressOverride,
+		"ipv4_configuration.dhcp_configuration.dns_server_ip_addresses_override": dhcp.DNSServerIPAddressesOverride,
+		"ipv4_configuration.dhcp_configuration.lease_time_seconds":               dhcp.LeaseTimeSeconds,
+		"ipv4_configuration.dhcp_configuration.domain_name":                      dhcp.DomainName,
+		"ipv4_configuration.dhcp_configuration.pxe_configuration":                dhcp.PxeConfiguration,
+		"ipv4_configuration.dhcp_configuration.ntp_server_ip_addresses":          dhcp.NtpServerIPAddresses,
+	}
+	for name, value := range nulls {
+		if !value.IsNull() {
+			t.Errorf("%s = %v, want null", name, value)
+		}
+	}
+}
+
+// TestNetworkResourceCreateReadRoundTrip creates a network against a fake controller, maps the
+// create response the way Create does and reads the network back with the stored ID. The read
+// must find the network and map to the same state.
+func TestNetworkResourceCreateReadRoundTrip(t *testing.T) {
+	const body = `{
+		"id": "6583f7a1e4b0c2a1d3f4a5b6",
+		"name": "IoT",
+		"enabled": true,
+		"vlanId": 30,
+		"management": "gateway",
+		"isolationEnabled": true,
+		"internetAccessEnabled": true,
+		"mdnsForwardingEnabled": false,
+		"cellularBackupEnabled": false,
+		"zoneId": "zone-1",
+		"ipv4Configuration": {
+			"hostIpAddress": "192.168.30.1",
+			"prefixLength": 24,
+			"dhcpConfiguration": {"mode": "server", "leaseTimeSeconds": 86400}
+		}
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/sites/site-1/networks",
+			req.Method == http.MethodGet && req.URL.Path == "/v1/sites/site-1/networks/6583f7a1e4b0c2a1d3f4a5b6":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &NetworkResource{client: network.NewClient("test", network.WithBaseURL(server.URL))}
+	var diags diag.Diagnostics
+
+	created, err := r.client.CreateNetwork(ctx, networktypes.CreateNetworkRequest{SiteID: "site-1", Name: "IoT"})
+	if err != nil {
+		t.Fatalf("CreateNetwork: %s", err)
+	}
+	data := NetworkResourceModel{
+		SiteID: types.StringValue("site-1"),
+		ID:     types.StringValue(created.ID),
+	}
+	r.mapResponseToModel(ctx, created, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := data.ID.ValueString(); got != "6583f7a1e4b0c2a1d3f4a5b6" {
+		t.Errorf("id = %q, want %q", got, "6583f7a1e4b0c2a1d3f4a5b6")
+	}
+	if got := data.Name.ValueString(); got != "IoT" {
+		t.Errorf("name = %q, want %q", got, "IoT")
+	}
+	if got := data.VlanID.ValueInt64(); got != 30 {
+		t.Errorf("vlan_id = %d, want 30", got)
+	}
+	if got := data.Management.ValueString(); got != "gateway" {
+		t.Errorf("management = %q, want %q", got, "gateway")
+	}
+	if !data.IsolationEnabled.ValueBool() {
+		t.Errorf("isolation_enabled = %s, want true", data.IsolationEnabled)
+	}
+	if got := data.ZoneID.ValueString(); got != "zone-1" {
+		t.Errorf("zone_id = %q, want %q", got, "zone-1")
+	}
+	if !data.DeviceID.IsNull() {
+		t.Errorf("device_id = %s, want null", data.DeviceID)
+	}
+
+	read, err := r.client.GetNetworkDetails(ctx, networktypes.GetNetworkDetailsRequest{
+		SiteID:    data.SiteID.ValueString(),
+		NetworkID: data.ID.ValueString(),
+	})
+	if err != nil {
+		t.Fatalf("GetNetworkDetails with the created ID: %s", err)
+	}
+	readData := data
+	r.mapResponseToModel(ctx, read, &readData, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	compare := map[string][2]attr.Value{
+		"id":                 {readData.ID, data.ID},
+		"name":               {readData.Name, data.Name},
+		"vlan_id":            {readData.VlanID, data.VlanID},
+		"zone_id":            {readData.ZoneID, data.ZoneID},
+		"ipv4_configuration": {readData.IPv4Configuration, data.IPv4Configuration},
+	}
+	for name, values := range compare {
+		if !values[0].Equal(values[1]) {
+			t.Errorf("read after create changed %s: got %s, want %s", name, values[0], values[1])
+		}
+	}
+}
+
+func TestNetworkDHCPModeValidator(t *testing.T) {
+	r := &NetworkResource{}
+	ipv4Type := testAttributeObjectType(t, r, "ipv4_configuration")
+	dhcpType, ok := ipv4Type.AttributeTypes["dhcp_configuration"].(tftypes.Object)
+	if !ok {
+		t.Fatal("dhcp_configuration is not an object")
+	}
+	rangeType, ok := dhcpType.AttributeTypes["ip_address_range"].(tftypes.Object)
+	if !ok {
+		t.Fatal("ip_address_range is not an object")
+	}
+	serversType := tftypes.List{ElementType: tftypes.String}
+	addressRange := testObjectValue(rangeType, map[string]tftypes.Value{
+		"start": tftypes.NewValue(tftypes.String, "192.168.1.100"),
+		"stop":  tftypes.NewValue(tftypes.String, "192.168.1.200"),
+	})
+
+	cases := map[string]struct {
+		dhcp       map[string]tftypes.Value
+		wantErrors int
+	}{
+		"server with a range": {
+			dhcp: map[string]tftypes.Value{
+				"mode":             tftypes.NewValue(tftypes.String, "dhcp-server"),
+				"ip_address_range": addressRange,
+			},
+		},
+		"relay with servers": {
+			dhcp: map[string]tftypes.Value{
+				"mode": tftypes.NewValue(tftypes.String, "dhcp-relay"),
+				"dhcp_server_ip_addresses": tftypes.NewValue(serversType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "10.0.0.1"),
+				}),
+			},
+		},
+		"relay without servers": {
+			dhcp: map[string]tftypes.Value{
+				"mode": tftypes.NewValue(tftypes.String, "dhcp-relay"),
+			},
+			wantErrors: 1,
+		},
+		"relay with an empty server list": {
+			dhcp: map[string]tftypes.Value{
+				"mode":                     tftypes.NewValue(tftypes.String, "dhcp-relay"),
+				"dhcp_server_ip_addresses": tftypes.NewValue(serversType, []tftypes.Value{}),
+			},
+			wantErrors: 1,
+		},
+		"relay with unknown servers": {
+			dhcp: map[string]tftypes.Value{
+				"mode":                     tftypes.NewValue(tftypes.String, "dhcp-relay"),
+				"dhcp_server_ip_addresses": tftypes.NewValue(serversType, tftypes.UnknownValue),
+			},
+		},
+		"none with a range": {
+			dhcp: map[string]tftypes.Value{
+				"mode":             tftypes.NewValue(tftypes.String, "none"),
+				"ip_address_range": addressRange,
+			},
+			wantErrors: 1,
+		},
+		"unknown mode": {
+			dhcp: map[string]tftypes.Value{
+				"mode": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := testResourceConfig(t, r, map[string]tftypes.Value{
+				"ipv4_configuration": testObjectValue(ipv4Type, map[string]tftypes.Value{
+					"dhcp_configuration": testObjectValue(dhcpType, tc.dhcp),
+				}),
+			})
+			resp := validateResourceConfig(t, networkDHCPModeValidator{}, config)
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+
+	t.Run("no ipv4_configuration", func(t *testing.T) {
+		resp := validateResourceConfig(t, networkDHCPModeValidator{}, testResourceConfig(t, r, nil))
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	})
+}
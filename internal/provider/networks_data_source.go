@@ -116,16 +116,19 @@ func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadReques
 		"site_id": data.SiteID.ValueString(),
 	})
 
-	networksResp, err := d.client.ListNetworks(ctx, networktypes.ListNetworksRequest{
-		SiteID: data.SiteID.ValueString(),
+	networks, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.Network], error) {
+		return d.client.ListNetworks(ctx, networktypes.ListNetworksRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networks: %s", err))
 		return
 	}
 
-	data.Networks = make([]NetworkSummaryModel, 0, len(networksResp.Data))
-	for _, n := range networksResp.Data {
+	data.Networks = make([]NetworkSummaryModel, 0, len(networks))
+	for _, n := range networks {
 		data.Networks = append(data.Networks, NetworkSummaryModel{
 			ID:         types.StringValue(n.ID),
 			Name:       types.StringValue(n.Name),
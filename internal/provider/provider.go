@@ -5,17 +5,26 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/murasame29/unifi-client-go/services/network"
 	sitemanager "github.com/murasame29/unifi-client-go/services/site-manager"
+	"golang.org/x/time/rate"
 )
 
 var _ provider.Provider = &UnifiNetworkProvider{}
@@ -25,8 +34,15 @@ type UnifiNetworkProvider struct {
 }
 
 type UnifiNetworkProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"base_url"`
+	APIKey             types.String  `tfsdk:"api_key"`
+	BaseURL            types.String  `tfsdk:"base_url"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryBackoffMS     types.Int64   `tfsdk:"retry_backoff_ms"`
+	RequestTimeout     types.Int64   `tfsdk:"request_timeout_seconds"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	Headers            types.Map     `tfsdk:"headers"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
 }
 
 type UnifiClients struct {
@@ -52,6 +68,48 @@ func (p *UnifiNetworkProvider) Schema(ctx context.Context, req provider.SchemaRe
 				MarkdownDescription: "The base URL for the UniFi Cloud API. Defaults to `https://api.ui.com`. Can also be set via the `UNIFI_BASE_URL` environment variable.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a request is retried after a rate limited (429) response, or after a server error or connection failure on a read. Set to `0` to disable retries. Defaults to `3`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_backoff_ms": schema.Int64Attribute{
+				MarkdownDescription: "The delay in milliseconds before the first retry. The delay doubles on each subsequent retry, and a `Retry-After` header returned by the API takes precedence. Defaults to `500`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The maximum time in seconds a single API request may take, including any retries. This bounds each call to the UniFi API, not the overall create, read, update or delete of a resource, which may issue several requests. Set to `0` to disable the timeout. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of API requests sent per second, shared by all resources and data sources, including retries. Use it to stay under the rate limits of the UniFi Cloud API when applying large configurations. Values below `1` space requests more than a second apart. Time spent waiting for a slot counts towards `request_timeout_seconds`. Set to `0` or leave unset to send requests without limit.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Extra HTTP headers sent with every API request, for example a token required by an authenticating proxy in front of a self-hosted console. " +
+					"They cannot replace the headers the provider sets itself: `X-API-Key` is rejected (use `api_key` instead), and `Accept` and `Content-Type` are ignored.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip verification of the API's TLS certificate. Only meant for testing against a self-hosted console; prefer `ca_cert_pem`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates to trust in addition to the system roots, for a self-hosted console with a self-signed or private certificate.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -85,7 +143,92 @@ func (p *UnifiNetworkProvider) Configure(ctx context.Context, req provider.Confi
 		baseURL = config.BaseURL.ValueString()
 	}
 
-	var opts []network.Option
+	maxRetries := int64(defaultMaxRetries)
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	retryBackoffMS := int64(defaultRetryBackoffMS)
+	if !config.RetryBackoffMS.IsNull() {
+		retryBackoffMS = config.RetryBackoffMS.ValueInt64()
+	}
+
+	requestTimeout := int64(defaultRequestTimeoutSeconds)
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueInt64()
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if config.InsecureSkipVerify.ValueBool() || config.CACertPEM.ValueString() != "" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if pem := config.CACertPEM.ValueString(); pem != "" {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM([]byte(pem)) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("ca_cert_pem"),
+					"Invalid CA Certificate",
+					"No PEM-encoded certificate could be parsed from ca_cert_pem.",
+				)
+				return
+			}
+			tlsConfig.RootCAs = pool
+		}
+		if config.InsecureSkipVerify.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("insecure_skip_verify"),
+				"TLS Verification Disabled",
+				"The API's TLS certificate is not verified, so the API key and all traffic can be intercepted. "+
+					"Use ca_cert_pem to trust a self-signed certificate instead.",
+			)
+			tlsConfig.InsecureSkipVerify = true
+		}
+		baseTransport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+		if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+			baseTransport = defaultTransport.Clone()
+		}
+		baseTransport.TLSClientConfig = tlsConfig
+		transport = baseTransport
+	}
+	if !config.Headers.IsNull() {
+		var headers map[string]string
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for name := range headers {
+			if http.CanonicalHeaderKey(name) == http.CanonicalHeaderKey(apiKeyHeader) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("headers"),
+					"API Key Set In Headers",
+					fmt.Sprintf("The %s header is set by the provider from api_key and cannot be set in headers.", name),
+				)
+				return
+			}
+		}
+		transport = &headerTransport{base: transport, headers: headers}
+	}
+	if requestsPerSecond := config.RequestsPerSecond.ValueFloat64(); requestsPerSecond > 0 {
+		// A burst of one spaces requests evenly instead of letting a batch through at once.
+		transport = &rateLimitTransport{
+			base:    transport,
+			limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), 1),
+		}
+	}
+
+	httpClient := &http.Client{
+		// A zero timeout means no timeout.
+		Timeout: time.Duration(requestTimeout) * time.Second,
+		Transport: &retryTransport{
+			base:       transport,
+			maxRetries: int(maxRetries),
+			backoff:    time.Duration(retryBackoffMS) * time.Millisecond,
+		},
+	}
+
+	opts := []network.Option{network.WithHTTPClient(httpClient)}
 	if baseURL != "" {
 		opts = append(opts, network.WithBaseURL(baseURL))
 	}
@@ -109,8 +252,10 @@ func (p *UnifiNetworkProvider) Resources(ctx context.Context) []func() resource.
 		NewDNSPolicyResource,
 		NewFirewallZoneResource,
 		NewFirewallPolicyResource,
+		NewFirewallPolicyBulkToggleResource,
 		NewTrafficMatchingListResource,
 		NewVoucherResource,
+		NewDeviceResource,
 	}
 }
 
@@ -119,19 +264,25 @@ func (p *UnifiNetworkProvider) DataSources(ctx context.Context) []func() datasou
 		NewSitesDataSource,
 		NewNetworkDataSource,
 		NewNetworksDataSource,
+		NewAvailableSubnetDataSource,
 		NewDevicesDataSource,
 		NewDeviceDataSource,
+		NewClientDataSource,
 		NewClientsDataSource,
 		NewACLRulesDataSource,
+		NewDNSPolicyDataSource,
 		NewDNSPoliciesDataSource,
 		NewFirewallZonesDataSource,
 		NewFirewallPoliciesDataSource,
+		NewFirewallPolicyDataSource,
+		NewTrafficMatchingListDataSource,
 		NewTrafficMatchingListsDataSource,
 		NewVouchersDataSource,
 		NewWANInterfacesDataSource,
 		NewVPNTunnelsDataSource,
 		NewVPNServersDataSource,
 		NewRadiusProfilesDataSource,
+		NewWifiBroadcastDataSource,
 		NewWifiBroadcastsDataSource,
 	}
 }
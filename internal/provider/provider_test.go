@@ -0,0 +1,71 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testResourceConfig returns a configuration for the resource in which only the given
+// top-level attributes are set.
+func testResourceConfig(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	objType, ok := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+	return tfsdk.Config{
+		Schema: resp.Schema,
+		Raw:    testObjectValue(objType, values),
+	}
+}
+
+// testAttributeObjectType returns the type of a top-level nested attribute of the resource.
+func testAttributeObjectType(t *testing.T, r resource.Resource, name string) tftypes.Object {
+	t.Helper()
+	configType, ok := testResourceConfig(t, r, nil).Raw.Type().(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+	attrType, ok := configType.AttributeTypes[name].(tftypes.Object)
+	if !ok {
+		t.Fatalf("attribute %s is not an object", name)
+	}
+	return attrType
+}
+
+// testObjectValue returns an object of the given type with the given attributes set and
+// every other attribute null.
+func testObjectValue(objType tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tftypes.NewValue(objType, attrs)
+}
+
+// validateResourceConfig runs a config validator against the configuration.
+func validateResourceConfig(t *testing.T, v resource.ConfigValidator, config tfsdk.Config) resource.ValidateConfigResponse {
+	t.Helper()
+	var resp resource.ValidateConfigResponse
+	v.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+	return resp
+}
@@ -75,16 +75,19 @@ func (d *RadiusProfilesDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	result, err := d.client.ListRadiusProfiles(ctx, networktypes.ListRadiusProfilesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.RadiusProfile], error) {
+		return d.client.ListRadiusProfiles(ctx, networktypes.ListRadiusProfilesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS profiles: %s", err))
 		return
 	}
 
-	data.Profiles = make([]RadiusProfileSummary, 0, len(result.Data))
-	for _, p := range result.Data {
+	data.Profiles = make([]RadiusProfileSummary, 0, len(result))
+	for _, p := range result {
 		data.Profiles = append(data.Profiles, RadiusProfileSummary{
 			ID:   types.StringValue(p.ID),
 			Name: types.StringValue(p.Name),
@@ -94,14 +94,18 @@ func (d *SitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	tflog.Debug(ctx, "Reading UniFi sites")
 
-	sitesResp, err := d.client.ListSites(ctx, networktypes.ListSitesRequest{})
+	sites, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.Site], error) {
+		return d.client.ListSites(ctx, networktypes.ListSitesRequest{
+			Pagination: page,
+		})
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sites: %s", err))
 		return
 	}
 
-	data.Sites = make([]SiteModel, 0, len(sitesResp.Data))
-	for _, site := range sitesResp.Data {
+	data.Sites = make([]SiteModel, 0, len(sites))
+	for _, site := range sites {
 		data.Sites = append(data.Sites, SiteModel{
 			ID:                types.StringValue(site.ID),
 			Name:              types.StringValue(site.Name),
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ datasource.DataSource = &TrafficMatchingListDataSource{}
+
+func NewTrafficMatchingListDataSource() datasource.DataSource {
+	return &TrafficMatchingListDataSource{}
+}
+
+// TrafficMatchingListDataSource exposes the same attributes as the unifi_traffic_matching_list resource.
+type TrafficMatchingListDataSource struct {
+	client *network.Client
+}
+
+func (d *TrafficMatchingListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_matching_list"
+}
+
+func (d *TrafficMatchingListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the items of a specific UniFi traffic matching list.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the traffic matching list.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the traffic matching list.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type (PORTS, IPV4_ADDRESSES, IPV6_ADDRESSES).",
+				Computed:            true,
+			},
+			"port_items": schema.ListNestedAttribute{
+				MarkdownDescription: "Port items (for PORTS type).",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Item type (single, range).",
+							Computed:            true,
+						},
+						"value": schema.Int64Attribute{
+							MarkdownDescription: "Single port value.",
+							Computed:            true,
+						},
+						"start": schema.Int64Attribute{
+							MarkdownDescription: "Range start port.",
+							Computed:            true,
+						},
+						"stop": schema.Int64Attribute{
+							MarkdownDescription: "Range stop port.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ip_address_items":   getTrafficMatchingListIPItemsDataSourceSchema("IPv4", "IPV4_ADDRESSES"),
+			"ipv6_address_items": getTrafficMatchingListIPItemsDataSourceSchema("IPv6", "IPV6_ADDRESSES"),
+		},
+	}
+}
+
+func getTrafficMatchingListIPItemsDataSourceSchema(family, listType string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: family + " address items (for " + listType + " type).",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Item type (single, range, subnet).",
+					Computed:            true,
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "Single " + family + " address or subnet.",
+					Computed:            true,
+				},
+				"start": schema.StringAttribute{
+					MarkdownDescription: "Range start " + family + " address.",
+					Computed:            true,
+				},
+				"stop": schema.StringAttribute{
+					MarkdownDescription: "Range stop " + family + " address.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *TrafficMatchingListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData))
+		return
+	}
+	d.client = clients.Network
+}
+
+func (d *TrafficMatchingListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TrafficMatchingListResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading traffic matching list", map[string]interface{}{
+		"site_id": data.SiteID.ValueString(),
+		"list_id": data.ID.ValueString(),
+	})
+
+	result, err := d.client.GetTrafficMatchingList(ctx, networktypes.GetTrafficMatchingListRequest{
+		SiteID: data.SiteID.ValueString(),
+		ListID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic matching list: %s", err))
+		return
+	}
+
+	// Reuse the resource mapping so the data source output matches the resource attributes.
+	var mapper TrafficMatchingListResource
+	mapper.mapResponseToModel(result, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -8,11 +8,12 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/murasame29/unifi-client-go/services/network"
@@ -94,6 +95,9 @@ func (r *TrafficMatchingListResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: "IPv4 address items (for IPV4_ADDRESSES type).",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						ipAddressItemValidator{},
+					},
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
 							MarkdownDescription: "Item type (single, range, subnet).",
@@ -118,6 +122,9 @@ func (r *TrafficMatchingListResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: "IPv6 address items (for IPV6_ADDRESSES type).",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						ipAddressItemValidator{ipv6: true},
+					},
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
 							MarkdownDescription: "Item type (single, range, subnet).",
@@ -252,89 +259,20 @@ func (r *TrafficMatchingListResource) Read(ctx context.Context, req resource.Rea
 		ListID: data.ID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic matching list: %s", err))
-		return
-	}
-
-	data.Name = types.StringValue(result.Name)
-	data.Type = types.StringValue(result.Type)
-
-	portItemAttrTypes := map[string]attr.Type{
-		"type":  types.StringType,
-		"value": types.Int64Type,
-		"start": types.Int64Type,
-		"stop":  types.Int64Type,
-	}
-
-	if len(result.PortItems) > 0 {
-		var portElements []attr.Value
-		for _, item := range result.PortItems {
-			attrValues := map[string]attr.Value{
-				"type": types.StringValue(item.Type),
-			}
-			if item.Value != nil {
-				attrValues["value"] = types.Int64Value(int64(*item.Value))
-			} else {
-				attrValues["value"] = types.Int64Null()
-			}
-			if item.Start != nil {
-				attrValues["start"] = types.Int64Value(int64(*item.Start))
-			} else {
-				attrValues["start"] = types.Int64Null()
-			}
-			if item.Stop != nil {
-				attrValues["stop"] = types.Int64Value(int64(*item.Stop))
-			} else {
-				attrValues["stop"] = types.Int64Null()
-			}
-			obj, d := types.ObjectValue(portItemAttrTypes, attrValues)
-			resp.Diagnostics.Append(d...)
-			portElements = append(portElements, obj)
-		}
-		portList, d := types.ListValue(types.ObjectType{AttrTypes: portItemAttrTypes}, portElements)
-		resp.Diagnostics.Append(d...)
-		data.PortItems = portList
-	}
-
-	ipItemAttrTypes := map[string]attr.Type{
-		"type":  types.StringType,
-		"value": types.StringType,
-		"start": types.StringType,
-		"stop":  types.StringType,
-	}
-
-	if len(result.IPAddressItems) > 0 {
-		var ipElements []attr.Value
-		for _, item := range result.IPAddressItems {
-			obj, d := types.ObjectValue(ipItemAttrTypes, map[string]attr.Value{
-				"type":  types.StringValue(item.Type),
-				"value": types.StringValue(item.Value),
-				"start": types.StringValue(item.Start),
-				"stop":  types.StringValue(item.Stop),
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The traffic matching list no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
 			})
-			resp.Diagnostics.Append(d...)
-			ipElements = append(ipElements, obj)
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		ipList, d := types.ListValue(types.ObjectType{AttrTypes: ipItemAttrTypes}, ipElements)
-		resp.Diagnostics.Append(d...)
-		data.IPAddressItems = ipList
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic matching list: %s", err))
+		return
 	}
 
-	if len(result.IPV6AddressItems) > 0 {
-		var ipv6Elements []attr.Value
-		for _, item := range result.IPV6AddressItems {
-			obj, d := types.ObjectValue(ipItemAttrTypes, map[string]attr.Value{
-				"type":  types.StringValue(item.Type),
-				"value": types.StringValue(item.Value),
-				"start": types.StringValue(item.Start),
-				"stop":  types.StringValue(item.Stop),
-			})
-			resp.Diagnostics.Append(d...)
-			ipv6Elements = append(ipv6Elements, obj)
-		}
-		ipv6List, d := types.ListValue(types.ObjectType{AttrTypes: ipItemAttrTypes}, ipv6Elements)
-		resp.Diagnostics.Append(d...)
-		data.IPv6AddressItems = ipv6List
+	r.mapResponseToModel(result, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -428,5 +366,88 @@ func (r *TrafficMatchingListResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *TrafficMatchingListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
+}
+
+func (r *TrafficMatchingListResource) mapResponseToModel(result *networktypes.TrafficMatchingList, data *TrafficMatchingListResourceModel, diags *diag.Diagnostics) {
+	data.Name = types.StringValue(result.Name)
+	data.Type = types.StringValue(result.Type)
+
+	portItemAttrTypes := map[string]attr.Type{
+		"type":  types.StringType,
+		"value": types.Int64Type,
+		"start": types.Int64Type,
+		"stop":  types.Int64Type,
+	}
+
+	if len(result.PortItems) > 0 {
+		var portElements []attr.Value
+		for _, item := range result.PortItems {
+			attrValues := map[string]attr.Value{
+				"type": types.StringValue(item.Type),
+			}
+			if item.Value != nil {
+				attrValues["value"] = types.Int64Value(int64(*item.Value))
+			} else {
+				attrValues["value"] = types.Int64Null()
+			}
+			if item.Start != nil {
+				attrValues["start"] = types.Int64Value(int64(*item.Start))
+			} else {
+				attrValues["start"] = types.Int64Null()
+			}
+			if item.Stop != nil {
+				attrValues["stop"] = types.Int64Value(int64(*item.Stop))
+			} else {
+				attrValues["stop"] = types.Int64Null()
+			}
+			obj, d := types.ObjectValue(portItemAttrTypes, attrValues)
+			diags.Append(d...)
+			portElements = append(portElements, obj)
+		}
+		portList, d := types.ListValue(types.ObjectType{AttrTypes: portItemAttrTypes}, portElements)
+		diags.Append(d...)
+		data.PortItems = portList
+	}
+
+	ipItemAttrTypes := map[string]attr.Type{
+		"type":  types.StringType,
+		"value": types.StringType,
+		"start": types.StringType,
+		"stop":  types.StringType,
+	}
+
+	if len(result.IPAddressItems) > 0 {
+		var ipElements []attr.Value
+		for _, item := range result.IPAddressItems {
+			obj, d := types.ObjectValue(ipItemAttrTypes, map[string]attr.Value{
+				"type":  types.StringValue(item.Type),
+				"value": types.StringValue(item.Value),
+				"start": types.StringValue(item.Start),
+				"stop":  types.StringValue(item.Stop),
+			})
+			diags.Append(d...)
+			ipElements = append(ipElements, obj)
+		}
+		ipList, d := types.ListValue(types.ObjectType{AttrTypes: ipItemAttrTypes}, ipElements)
+		diags.Append(d...)
+		data.IPAddressItems = ipList
+	}
+
+	if len(result.IPV6AddressItems) > 0 {
+		var ipv6Elements []attr.Value
+		for _, item := range result.IPV6AddressItems {
+			obj, d := types.ObjectValue(ipItemAttrTypes, map[string]attr.Value{
+				"type":  types.StringValue(item.Type),
+				"value": types.StringValue(item.Value),
+				"start": types.StringValue(item.Start),
+				"stop":  types.StringValue(item.Stop),
+			})
+			diags.Append(d...)
+			ipv6Elements = append(ipv6Elements, obj)
+		}
+		ipv6List, d := types.ListValue(types.ObjectType{AttrTypes: ipItemAttrTypes}, ipv6Elements)
+		diags.Append(d...)
+		data.IPv6AddressItems = ipv6List
+	}
 }
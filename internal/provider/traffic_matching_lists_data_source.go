@@ -77,16 +77,19 @@ func (d *TrafficMatchingListsDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	result, err := d.client.ListTrafficMatchingLists(ctx, networktypes.ListTrafficMatchingListsRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.TrafficMatchingList], error) {
+		return d.client.ListTrafficMatchingLists(ctx, networktypes.ListTrafficMatchingListsRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic matching lists: %s", err))
 		return
 	}
 
-	data.Lists = make([]TrafficMatchingListSummary, 0, len(result.Data))
-	for _, l := range result.Data {
+	data.Lists = make([]TrafficMatchingListSummary, 0, len(result))
+	for _, l := range result {
 		data.Lists = append(data.Lists, TrafficMatchingListSummary{
 			ID:   types.StringValue(l.ID),
 			Name: types.StringValue(l.Name),
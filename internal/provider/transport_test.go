@@ -0,0 +1,184 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// statusSequenceServer answers each request with the next status in statuses, and with
+// 200 once they run out. It records the body of every request it receives.
+type statusSequenceServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	headers  []http.Header
+	bodies   []string
+}
+
+func newStatusSequenceServer(t *testing.T, statuses []int, headers []http.Header) *statusSequenceServer {
+	t.Helper()
+	s := &statusSequenceServer{statuses: statuses, headers: headers}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		s.mu.Lock()
+		attempt := len(s.bodies)
+		s.bodies = append(s.bodies, string(body))
+		s.mu.Unlock()
+
+		if attempt < len(s.headers) {
+			for name, values := range s.headers[attempt] {
+				w.Header()[name] = values
+			}
+		}
+		status := http.StatusOK
+		if attempt < len(s.statuses) {
+			status = s.statuses[attempt]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *statusSequenceServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.bodies)
+}
+
+func TestRetryTransportRetriesRateLimitedRequests(t *testing.T) {
+	server := newStatusSequenceServer(t, []int{http.StatusTooManyRequests, http.StatusTooManyRequests}, nil)
+	transport := &retryTransport{base: http.DefaultTransport, maxRetries: 3, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"LAN"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := req.Body
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	requests := server.requests()
+	if len(requests) != 3 {
+		t.Fatalf("got %d requests, want 3", len(requests))
+	}
+	for i, got := range requests {
+		if got != `{"name":"LAN"}` {
+			t.Errorf("request %d body = %q, want the original body", i, got)
+		}
+	}
+	if req.Body != body {
+		t.Error("RoundTrip replaced the body of the caller's request")
+	}
+}
+
+func TestRetryTransportHonorsRetryAfter(t *testing.T) {
+	server := newStatusSequenceServer(t,
+		[]int{http.StatusTooManyRequests},
+		[]http.Header{{"Retry-After": []string{"1"}}},
+	)
+	// The backoff alone would retry almost immediately.
+	transport := &retryTransport{base: http.DefaultTransport, maxRetries: 1, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the 1s from Retry-After", elapsed)
+	}
+}
+
+func TestRetryTransportServerErrors(t *testing.T) {
+	cases := map[string]struct {
+		method       string
+		wantRequests int
+		wantStatus   int
+	}{
+		"GET is retried":      {method: http.MethodGet, wantRequests: 2, wantStatus: http.StatusOK},
+		"POST is not retried": {method: http.MethodPost, wantRequests: 1, wantStatus: http.StatusServiceUnavailable},
+		"PUT is not retried":  {method: http.MethodPut, wantRequests: 1, wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := newStatusSequenceServer(t, []int{http.StatusServiceUnavailable}, nil)
+			transport := &retryTransport{base: http.DefaultTransport, maxRetries: 3, backoff: time.Millisecond}
+
+			var body io.Reader
+			if tc.method != http.MethodGet {
+				body = strings.NewReader(`{}`)
+			}
+			req, err := http.NewRequest(tc.method, server.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+			if got := len(server.requests()); got != tc.wantRequests {
+				t.Errorf("got %d requests, want %d", got, tc.wantRequests)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		"empty":             {value: "", wantOK: false},
+		"seconds":           {value: "5", want: 5 * time.Second, wantOK: true},
+		"capped seconds":    {value: "3600", want: maxRetryBackoff, wantOK: true},
+		"negative seconds":  {value: "-1", wantOK: false},
+		"date in the past":  {value: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0, wantOK: true},
+		"unparsable header": {value: "soon", wantOK: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tc.value)
+			if ok != tc.wantOK || got != tc.want {
+				t.Errorf("parseRetryAfter(%q) = %s, %t, want %s, %t", tc.value, got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	minDHCPLeaseTimeSeconds = 60
+	maxDHCPLeaseTimeSeconds = 31536000
+)
+
+var _ validator.String = ipv4AddressValidator{}
+
+// ipv4AddressValidator validates that a string is an IPv4 address.
+type ipv4AddressValidator struct{}
+
+func (v ipv4AddressValidator) Description(ctx context.Context) string {
+	return "value must be a valid IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(req.ConfigValue.ValueString())
+	if err != nil || !addr.Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Address",
+			fmt.Sprintf("Expected a valid IPv4 address, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = ipv6AddressValidator{}
+
+// ipv6AddressValidator validates that a string is an IPv6 address or suffix such as `::100`.
+type ipv6AddressValidator struct{}
+
+func (v ipv6AddressValidator) Description(ctx context.Context) string {
+	return "value must be a valid IPv6 address"
+}
+
+func (v ipv6AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv6AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(req.ConfigValue.ValueString())
+	if err != nil || !addr.Is6() || addr.Zone() != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Address",
+			fmt.Sprintf("Expected a valid IPv6 address or suffix (e.g. `::100`), got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.Object = ipv6RangeOrderValidator{}
+
+// ipv6RangeOrderValidator validates that the `start` attribute of a range object is not after its `stop` attribute.
+type ipv6RangeOrderValidator struct{}
+
+func (v ipv6RangeOrderValidator) Description(ctx context.Context) string {
+	return "start must be less than or equal to stop"
+}
+
+func (v ipv6RangeOrderValidator) MarkdownDescription(ctx context.Context) string {
+	return "`start` must be less than or equal to `stop`"
+}
+
+func (v ipv6RangeOrderValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	start, ok := knownStringAttr(req.ConfigValue.Attributes(), "start")
+	if !ok {
+		return
+	}
+	stop, ok := knownStringAttr(req.ConfigValue.Attributes(), "stop")
+	if !ok {
+		return
+	}
+
+	// Malformed addresses are reported by the attribute validators.
+	startAddr, err := netip.ParseAddr(start)
+	if err != nil {
+		return
+	}
+	stopAddr, err := netip.ParseAddr(stop)
+	if err != nil {
+		return
+	}
+
+	if startAddr.Compare(stopAddr) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Address Range",
+			fmt.Sprintf("Range start %q must be less than or equal to stop %q.", start, stop),
+		)
+	}
+}
+
+var _ validator.Object = portRangeOrderValidator{}
+
+// portRangeOrderValidator validates that the `start` port of a range object is not after its `stop` port.
+type portRangeOrderValidator struct{}
+
+func (v portRangeOrderValidator) Description(ctx context.Context) string {
+	return "start must be less than or equal to stop"
+}
+
+func (v portRangeOrderValidator) MarkdownDescription(ctx context.Context) string {
+	return "`start` must be less than or equal to `stop`"
+}
+
+func (v portRangeOrderValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	start, ok := req.ConfigValue.Attributes()["start"].(types.Int64)
+	if !ok || start.IsNull() || start.IsUnknown() {
+		return
+	}
+	stop, ok := req.ConfigValue.Attributes()["stop"].(types.Int64)
+	if !ok || stop.IsNull() || stop.IsUnknown() {
+		return
+	}
+
+	if start.ValueInt64() > stop.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port Range",
+			fmt.Sprintf("Range start %d must be less than or equal to stop %d.", start.ValueInt64(), stop.ValueInt64()),
+		)
+	}
+}
+
+func knownStringAttr(attrs map[string]attr.Value, name string) (string, bool) {
+	v, ok := attrs[name].(types.String)
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+	return v.ValueString(), true
+}
+
+var _ validator.String = wpaPassphraseValidator{}
+
+// wpaPassphraseValidator validates that a string is a WPA-PSK passphrase: 8 to 63
+// printable ASCII characters, or a raw 64 character hexadecimal key.
+type wpaPassphraseValidator struct{}
+
+func (v wpaPassphraseValidator) Description(ctx context.Context) string {
+	return "value must be 8 to 63 printable ASCII characters or 64 hexadecimal characters"
+}
+
+func (v wpaPassphraseValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v wpaPassphraseValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !isValidWPAPassphrase(req.ConfigValue.ValueString()) {
+		// The value is sensitive, so it is deliberately left out of the message.
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Passphrase",
+			"Expected a WPA passphrase of 8 to 63 printable ASCII characters, or a 64 character hexadecimal key.",
+		)
+	}
+}
+
+func isValidWPAPassphrase(s string) bool {
+	if len(s) == 64 {
+		for _, c := range s {
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+		return true
+	}
+	if len(s) < 8 || len(s) > 63 {
+		return false
+	}
+	for _, c := range s {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+var _ validator.Object = ipAddressItemValidator{}
+
+// ipAddressItemValidator validates the addresses of a traffic matching list item against
+// its item type (single, subnet or range) and the address family of the list it is in.
+type ipAddressItemValidator struct {
+	ipv6 bool
+}
+
+func (v ipAddressItemValidator) family() string {
+	if v.ipv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+func (v ipAddressItemValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be an %s address for single items and an %s CIDR for subnet items; start and stop must be %s addresses", v.family(), v.family(), v.family())
+}
+
+func (v ipAddressItemValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressItemValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	itemType, ok := knownStringAttr(attrs, "type")
+	if !ok {
+		return
+	}
+
+	switch strings.ToLower(itemType) {
+	case "single":
+		if value, ok := knownStringAttr(attrs, "value"); ok {
+			addr, err := netip.ParseAddr(value)
+			if err != nil || !v.matchesFamily(addr) {
+				v.addError(req, resp, "value", fmt.Sprintf("Expected an %s address for a single item, got: %q", v.family(), value))
+			}
+		}
+	case "subnet":
+		if value, ok := knownStringAttr(attrs, "value"); ok {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil || !v.matchesFamily(prefix.Addr()) {
+				v.addError(req, resp, "value", fmt.Sprintf("Expected an %s CIDR for a subnet item, got: %q", v.family(), value))
+			}
+		}
+	case "range":
+		for _, name := range []string{"start", "stop"} {
+			value, ok := knownStringAttr(attrs, name)
+			if !ok {
+				continue
+			}
+			addr, err := netip.ParseAddr(value)
+			if err != nil || !v.matchesFamily(addr) {
+				v.addError(req, resp, name, fmt.Sprintf("Expected an %s address for the range %s, got: %q", v.family(), name, value))
+			}
+		}
+	}
+}
+
+func (v ipAddressItemValidator) matchesFamily(addr netip.Addr) bool {
+	if v.ipv6 {
+		return addr.Is6() && !addr.Is4In6()
+	}
+	return addr.Is4()
+}
+
+func (v ipAddressItemValidator) addError(req validator.ObjectRequest, resp *validator.ObjectResponse, attrName, detail string) {
+	resp.Diagnostics.AddAttributeError(
+		req.Path.AtName(attrName),
+		"Invalid "+v.family()+" Matching Item",
+		detail,
+	)
+}
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator validates that a string is an IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be a valid IP address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(req.ConfigValue.ValueString())
+	if err != nil || addr.Zone() != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Expected a valid IP address, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that a string is a subnet in CIDR notation of the given address family.
+type cidrValidator struct {
+	ipv6 bool
+}
+
+func (v cidrValidator) family() string {
+	if v.ipv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be an %s subnet in CIDR notation", v.family())
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString())
+	if err != nil || prefix.Addr().Is6() != v.ipv6 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid "+v.family()+" Subnet",
+			fmt.Sprintf("Expected an %s subnet in CIDR notation, got: %q", v.family(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = ipAddressOrCIDRValidator{}
+
+// ipAddressOrCIDRValidator validates that a string is an IP address or a subnet in CIDR notation.
+type ipAddressOrCIDRValidator struct{}
+
+func (v ipAddressOrCIDRValidator) Description(ctx context.Context) string {
+	return "value must be a valid IP address or subnet in CIDR notation"
+}
+
+func (v ipAddressOrCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressOrCIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := netip.ParsePrefix(value); err == nil {
+		return
+	}
+	if addr, err := netip.ParseAddr(value); err == nil && addr.Zone() == "" {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address or Subnet",
+		fmt.Sprintf("Expected a valid IP address or subnet in CIDR notation, got: %q", value),
+	)
+}
+
+var _ validator.String = macAddressValidator{}
+
+// macAddressValidator validates that a string is a 48-bit MAC address. Colons,
+// hyphens and dots are all accepted as separators, in either case.
+type macAddressValidator struct{}
+
+func (v macAddressValidator) Description(ctx context.Context) string {
+	return "value must be a valid MAC address"
+}
+
+func (v macAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v macAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, ok := normalizeMACAddress(value); ok {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid MAC Address",
+		fmt.Sprintf("Expected a valid MAC address (e.g. aa:bb:cc:dd:ee:ff), got: %q", value),
+	)
+}
+
+// normalizeMACAddress returns s in lowercase colon-separated form, and false if
+// s is not a 48-bit MAC address.
+func normalizeMACAddress(s string) (string, bool) {
+	hw, err := net.ParseMAC(s)
+	if err != nil || len(hw) != 6 {
+		return "", false
+	}
+	return hw.String(), true
+}
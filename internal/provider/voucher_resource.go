@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,6 +36,7 @@ type VoucherResourceModel struct {
 	ID                   types.String `tfsdk:"id"`
 	Name                 types.String `tfsdk:"name"`
 	Code                 types.String `tfsdk:"code"`
+	Codes                types.List   `tfsdk:"codes"`
 	TimeLimitMinutes     types.Int64  `tfsdk:"time_limit_minutes"`
 	VoucherCount         types.Int64  `tfsdk:"voucher_count"`
 	AuthorizedGuestLimit types.Int64  `tfsdk:"authorized_guest_limit"`
@@ -41,6 +45,18 @@ type VoucherResourceModel struct {
 	TxRateLimitKbps      types.Int64  `tfsdk:"tx_rate_limit_kbps"`
 }
 
+type VoucherCodeModel struct {
+	ID   types.String `tfsdk:"id"`
+	Code types.String `tfsdk:"code"`
+}
+
+func getVoucherCodeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"code": types.StringType,
+	}
+}
+
 func (r *VoucherResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_voucher"
 }
@@ -65,8 +81,25 @@ func (r *VoucherResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"code": schema.StringAttribute{
-				MarkdownDescription: "The voucher code (generated).",
+				MarkdownDescription: "The voucher code (generated) of the first voucher.",
+				Computed:            true,
+			},
+			"codes": schema.ListNestedAttribute{
+				MarkdownDescription: "Every voucher generated by this resource, including the first one. All of them are deleted on destroy.",
 				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the voucher.",
+							Computed:            true,
+						},
+						"code": schema.StringAttribute{
+							MarkdownDescription: "The voucher code.",
+							Computed:            true,
+						},
+					},
+				},
 			},
 			"time_limit_minutes": schema.Int64Attribute{
 				MarkdownDescription: "Time limit in minutes. Defaults to `60`.",
@@ -155,6 +188,17 @@ func (r *VoucherResource) Create(ctx context.Context, req resource.CreateRequest
 		data.Code = types.StringValue(vouchersResp.Vouchers[0].Code)
 	}
 
+	codes := make([]VoucherCodeModel, 0, len(vouchersResp.Vouchers))
+	for _, v := range vouchersResp.Vouchers {
+		codes = append(codes, VoucherCodeModel{
+			ID:   types.StringValue(v.ID),
+			Code: types.StringValue(v.Code),
+		})
+	}
+	codesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: getVoucherCodeAttrTypes()}, codes)
+	resp.Diagnostics.Append(diags...)
+	data.Codes = codesList
+
 	tflog.Trace(ctx, "created voucher resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -166,15 +210,51 @@ func (r *VoucherResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	voucher, err := r.client.GetVoucherDetails(ctx, networktypes.GetVoucherDetailsRequest{
-		SiteID:    data.SiteID.ValueString(),
-		VoucherID: data.ID.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read voucher: %s", err))
+	codes := r.trackedVoucherCodes(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Vouchers that were used up or purged are dropped one by one; the resource is only
+	// removed once none of them are left, so the remaining ones are still deleted on destroy.
+	var voucher *networktypes.Voucher
+	remaining := make([]VoucherCodeModel, 0, len(codes))
+	for _, c := range codes {
+		v, err := r.client.GetVoucherDetails(ctx, networktypes.GetVoucherDetailsRequest{
+			SiteID:    data.SiteID.ValueString(),
+			VoucherID: c.ID.ValueString(),
+		})
+		if err != nil {
+			if isNotFound(err) {
+				tflog.Warn(ctx, "A voucher no longer exists, removing it from state", map[string]interface{}{
+					"id": c.ID.ValueString(),
+				})
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read voucher %s: %s", c.ID.ValueString(), err))
+			return
+		}
+		if voucher == nil {
+			voucher = v
+		}
+		remaining = append(remaining, VoucherCodeModel{
+			ID:   types.StringValue(v.ID),
+			Code: types.StringValue(v.Code),
+		})
+	}
+
+	if voucher == nil {
+		tflog.Warn(ctx, "None of the vouchers exist any more, removing them from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	codesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: getVoucherCodeAttrTypes()}, remaining)
+	resp.Diagnostics.Append(diags...)
+	data.Codes = codesList
+	data.ID = types.StringValue(voucher.ID)
 	data.Name = types.StringValue(voucher.Name)
 	data.Code = types.StringValue(voucher.Code)
 	data.TimeLimitMinutes = types.Int64Value(int64(voucher.TimeLimitMinutes))
@@ -212,14 +292,36 @@ func (r *VoucherResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err := r.client.DeleteVoucher(ctx, networktypes.DeleteVoucherRequest{
-		SiteID:    data.SiteID.ValueString(),
-		VoucherID: data.ID.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete voucher: %s", err))
+	codes := r.trackedVoucherCodes(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, c := range codes {
+		voucherID := c.ID.ValueString()
+		_, err := r.client.DeleteVoucher(ctx, networktypes.DeleteVoucherRequest{
+			SiteID:    data.SiteID.ValueString(),
+			VoucherID: voucherID,
+		})
+		// A voucher that has already expired and been purged needs no deleting.
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete voucher %s: %s", voucherID, err))
+		}
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	tflog.Trace(ctx, "deleted voucher resource")
 }
+
+// trackedVoucherCodes returns the vouchers recorded in state. State written before codes
+// was tracked only knows about the first voucher.
+func (r *VoucherResource) trackedVoucherCodes(ctx context.Context, data *VoucherResourceModel, diags *diag.Diagnostics) []VoucherCodeModel {
+	if data.Codes.IsNull() || data.Codes.IsUnknown() {
+		return []VoucherCodeModel{{ID: data.ID, Code: data.Code}}
+	}
+	var codes []VoucherCodeModel
+	diags.Append(data.Codes.ElementsAs(ctx, &codes, false)...)
+	return codes
+}
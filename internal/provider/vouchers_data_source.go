@@ -25,8 +25,10 @@ type VouchersDataSource struct {
 }
 
 type VouchersDataSourceModel struct {
-	SiteID   types.String     `tfsdk:"site_id"`
-	Vouchers []VoucherSummary `tfsdk:"vouchers"`
+	SiteID      types.String     `tfsdk:"site_id"`
+	ExpiredOnly types.Bool       `tfsdk:"expired_only"`
+	UsedOnly    types.Bool       `tfsdk:"used_only"`
+	Vouchers    []VoucherSummary `tfsdk:"vouchers"`
 }
 
 type VoucherSummary struct {
@@ -35,6 +37,7 @@ type VoucherSummary struct {
 	Code             types.String `tfsdk:"code"`
 	TimeLimitMinutes types.Int64  `tfsdk:"time_limit_minutes"`
 	Expired          types.Bool   `tfsdk:"expired"`
+	Used             types.Bool   `tfsdk:"used"`
 }
 
 func (d *VouchersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +49,14 @@ func (d *VouchersDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "Fetches the list of hotspot vouchers for a site.",
 		Attributes: map[string]schema.Attribute{
 			"site_id": schema.StringAttribute{Required: true},
+			"expired_only": schema.BoolAttribute{
+				MarkdownDescription: "Only return vouchers that have expired.",
+				Optional:            true,
+			},
+			"used_only": schema.BoolAttribute{
+				MarkdownDescription: "Only return vouchers whose authorized guest limit has been reached.",
+				Optional:            true,
+			},
 			"vouchers": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -55,6 +66,7 @@ func (d *VouchersDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 						"code":               schema.StringAttribute{Computed: true},
 						"time_limit_minutes": schema.Int64Attribute{Computed: true},
 						"expired":            schema.BoolAttribute{Computed: true},
+						"used":               schema.BoolAttribute{Computed: true},
 					},
 				},
 			},
@@ -81,24 +93,41 @@ func (d *VouchersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	result, err := d.client.ListVouchers(ctx, networktypes.ListVouchersRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.Voucher], error) {
+		return d.client.ListVouchers(ctx, networktypes.ListVouchersRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vouchers: %s", err))
 		return
 	}
 
-	data.Vouchers = make([]VoucherSummary, 0, len(result.Data))
-	for _, v := range result.Data {
+	data.Vouchers = make([]VoucherSummary, 0, len(result))
+	for _, v := range result {
+		used := isVoucherUsed(v)
+		if data.ExpiredOnly.ValueBool() && !v.Expired {
+			continue
+		}
+		if data.UsedOnly.ValueBool() && !used {
+			continue
+		}
 		data.Vouchers = append(data.Vouchers, VoucherSummary{
 			ID:               types.StringValue(v.ID),
 			Name:             types.StringValue(v.Name),
 			Code:             types.StringValue(v.Code),
 			TimeLimitMinutes: types.Int64Value(int64(v.TimeLimitMinutes)),
 			Expired:          types.BoolValue(v.Expired),
+			Used:             types.BoolValue(used),
 		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// isVoucherUsed reports whether a voucher has authorized as many guests as its limit allows.
+// Vouchers without a guest limit are never considered used.
+func isVoucherUsed(v networktypes.Voucher) bool {
+	return v.AuthorizedGuestLimit != nil && v.AuthorizedGuestCount >= *v.AuthorizedGuestLimit
+}
@@ -79,16 +79,19 @@ func (d *VPNServersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	result, err := d.client.ListVPNServers(ctx, networktypes.ListVPNServersRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.VPNServer], error) {
+		return d.client.ListVPNServers(ctx, networktypes.ListVPNServersRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPN servers: %s", err))
 		return
 	}
 
-	data.Servers = make([]VPNServerSummary, 0, len(result.Data))
-	for _, s := range result.Data {
+	data.Servers = make([]VPNServerSummary, 0, len(result))
+	for _, s := range result {
 		data.Servers = append(data.Servers, VPNServerSummary{
 			ID:      types.StringValue(s.ID),
 			Name:    types.StringValue(s.Name),
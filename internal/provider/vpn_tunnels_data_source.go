@@ -77,16 +77,19 @@ func (d *VPNTunnelsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	result, err := d.client.ListVPNTunnels(ctx, networktypes.ListVPNTunnelsRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.VPNTunnel], error) {
+		return d.client.ListVPNTunnels(ctx, networktypes.ListVPNTunnelsRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPN tunnels: %s", err))
 		return
 	}
 
-	data.Tunnels = make([]VPNTunnelSummary, 0, len(result.Data))
-	for _, t := range result.Data {
+	data.Tunnels = make([]VPNTunnelSummary, 0, len(result))
+	for _, t := range result {
 		data.Tunnels = append(data.Tunnels, VPNTunnelSummary{
 			ID:   types.StringValue(t.ID),
 			Name: types.StringValue(t.Name),
@@ -75,16 +75,19 @@ func (d *WANInterfacesDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	result, err := d.client.ListWANInterfaces(ctx, networktypes.ListWANInterfacesRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.WANInterface], error) {
+		return d.client.ListWANInterfaces(ctx, networktypes.ListWANInterfacesRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WAN interfaces: %s", err))
 		return
 	}
 
-	data.Interfaces = make([]WANInterfaceSummary, 0, len(result.Data))
-	for _, i := range result.Data {
+	data.Interfaces = make([]WANInterfaceSummary, 0, len(result))
+	for _, i := range result {
 		data.Interfaces = append(data.Interfaces, WANInterfaceSummary{
 			ID:   types.StringValue(i.ID),
 			Name: types.StringValue(i.Name),
@@ -0,0 +1,264 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/murasame29/unifi-client-go/services/network"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+var _ datasource.DataSource = &WifiBroadcastDataSource{}
+
+func NewWifiBroadcastDataSource() datasource.DataSource {
+	return &WifiBroadcastDataSource{}
+}
+
+// WifiBroadcastDataSource exposes the same attributes as the unifi_wifi_broadcast resource.
+type WifiBroadcastDataSource struct {
+	client *network.Client
+}
+
+func (d *WifiBroadcastDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_broadcast"
+}
+
+func (d *WifiBroadcastDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the full configuration of a specific UniFi WiFi broadcast (SSID).",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The site ID where the WiFi broadcast is located.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the WiFi broadcast.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name (SSID) of the WiFi broadcast.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of WiFi broadcast.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the WiFi broadcast is enabled.",
+				Computed:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "The network ID associated with this WiFi broadcast.",
+				Computed:            true,
+			},
+			"network_reference_type": schema.StringAttribute{
+				MarkdownDescription: "How the WiFi broadcast references its network.",
+				Computed:            true,
+			},
+			"guest_auth_method": schema.StringAttribute{
+				MarkdownDescription: "How guests authenticate on the hotspot portal (none, voucher, password, radius).",
+				Computed:            true,
+			},
+			"security_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "Security configuration for the WiFi broadcast.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Security type (open, wpa2, wpa3, wpa2wpa3, wpa2-enterprise, wpa3-enterprise, wpa2wpa3-enterprise).",
+						Computed:            true,
+					},
+					"passphrase": schema.StringAttribute{
+						MarkdownDescription: "WiFi passphrase.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+					"pmf_mode": schema.StringAttribute{
+						MarkdownDescription: "Protected Management Frames mode (disabled, optional, required).",
+						Computed:            true,
+					},
+					"fast_roaming_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether fast roaming (802.11r) is enabled.",
+						Computed:            true,
+					},
+					"group_rekey_interval_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Group rekey interval in seconds.",
+						Computed:            true,
+					},
+					"radius_profile_id": schema.StringAttribute{
+						MarkdownDescription: "RADIUS profile ID for enterprise authentication.",
+						Computed:            true,
+					},
+					"coa_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether RADIUS Change of Authorization is enabled.",
+						Computed:            true,
+					},
+					"security_mode": schema.StringAttribute{
+						MarkdownDescription: "Security mode.",
+						Computed:            true,
+					},
+					"wpa3_fast_roaming_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether WPA3 fast roaming is enabled.",
+						Computed:            true,
+					},
+				},
+			},
+			"broadcasting_device_filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Filter for broadcasting devices.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Filter type (all, include, exclude).",
+						Computed:            true,
+					},
+					"device_ids": schema.ListAttribute{
+						MarkdownDescription: "List of device IDs.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"device_tag_ids": schema.ListAttribute{
+						MarkdownDescription: "List of device tag IDs.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"blackout_schedule": schema.ListNestedAttribute{
+				MarkdownDescription: "Days and times during which the WiFi broadcast is turned off.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the blackout entry.",
+							Computed:            true,
+						},
+						"day": schema.StringAttribute{
+							MarkdownDescription: "The day of the week the entry applies to.",
+							Computed:            true,
+						},
+						"time_ranges": schema.ListNestedAttribute{
+							MarkdownDescription: "Time ranges on that day during which the broadcast is off.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start_time": schema.StringAttribute{
+										MarkdownDescription: "Start time (HH:MM, 24-hour).",
+										Computed:            true,
+									},
+									"end_time": schema.StringAttribute{
+										MarkdownDescription: "End time (HH:MM, 24-hour).",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"multicast_to_unicast_conversion_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether multicast to unicast conversion is enabled.",
+				Computed:            true,
+			},
+			"client_isolation_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether client isolation is enabled.",
+				Computed:            true,
+			},
+			"hide_name": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SSID is hidden.",
+				Computed:            true,
+			},
+			"uapsd_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether U-APSD (Unscheduled Automatic Power Save Delivery) is enabled.",
+				Computed:            true,
+			},
+			"broadcasting_frequencies_ghz": schema.ListAttribute{
+				MarkdownDescription: "List of broadcasting frequencies in GHz (2.4, 5, 6).",
+				Computed:            true,
+				ElementType:         types.Float64Type,
+			},
+			"mlo_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Multi-Link Operation (WiFi 7) is enabled.",
+				Computed:            true,
+			},
+			"band_steering_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether band steering is enabled.",
+				Computed:            true,
+			},
+			"arp_proxy_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether ARP proxy is enabled.",
+				Computed:            true,
+			},
+			"bss_transition_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether BSS transition (802.11v) is enabled.",
+				Computed:            true,
+			},
+			"advertise_device_name": schema.BoolAttribute{
+				MarkdownDescription: "Whether the device name is advertised.",
+				Computed:            true,
+			},
+			"basic_data_rate_2g_kbps": schema.Int64Attribute{
+				MarkdownDescription: "Minimum (basic) data rate on 2.4 GHz in kbps.",
+				Computed:            true,
+			},
+			"basic_data_rate_5g_kbps": schema.Int64Attribute{
+				MarkdownDescription: "Minimum (basic) data rate on 5 GHz in kbps.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WifiBroadcastDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*UnifiClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *UnifiClients, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clients.Network
+}
+
+func (d *WifiBroadcastDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WifiBroadcastResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading UniFi WiFi broadcast", map[string]interface{}{
+		"site_id":           data.SiteID.ValueString(),
+		"wifi_broadcast_id": data.ID.ValueString(),
+	})
+
+	wifiResp, err := d.client.GetWifiBroadcastDetails(ctx, networktypes.GetWifiBroadcastDetailsRequest{
+		SiteID:          data.SiteID.ValueString(),
+		WifiBroadcastID: data.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WiFi broadcast: %s", err))
+		return
+	}
+
+	// Reuse the resource mapping so the data source output matches the resource attributes.
+	var mapper WifiBroadcastResource
+	mapper.mapResponseToModel(ctx, wifiResp, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -6,16 +6,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,6 +33,8 @@ import (
 
 var _ resource.Resource = &WifiBroadcastResource{}
 var _ resource.ResourceWithImportState = &WifiBroadcastResource{}
+var _ resource.ResourceWithValidateConfig = &WifiBroadcastResource{}
+var _ resource.ResourceWithConfigValidators = &WifiBroadcastResource{}
 
 func NewWifiBroadcastResource() resource.Resource {
 	return &WifiBroadcastResource{}
@@ -41,8 +51,11 @@ type WifiBroadcastResourceModel struct {
 	Type                                types.String `tfsdk:"type"`
 	Enabled                             types.Bool   `tfsdk:"enabled"`
 	NetworkID                           types.String `tfsdk:"network_id"`
+	NetworkReferenceType                types.String `tfsdk:"network_reference_type"`
+	GuestAuthMethod                     types.String `tfsdk:"guest_auth_method"`
 	SecurityConfiguration               types.Object `tfsdk:"security_configuration"`
 	BroadcastingDeviceFilter            types.Object `tfsdk:"broadcasting_device_filter"`
+	BlackoutSchedule                    types.List   `tfsdk:"blackout_schedule"`
 	MulticastToUnicastConversionEnabled types.Bool   `tfsdk:"multicast_to_unicast_conversion_enabled"`
 	ClientIsolationEnabled              types.Bool   `tfsdk:"client_isolation_enabled"`
 	HideName                            types.Bool   `tfsdk:"hide_name"`
@@ -53,6 +66,36 @@ type WifiBroadcastResourceModel struct {
 	ArpProxyEnabled                     types.Bool   `tfsdk:"arp_proxy_enabled"`
 	BssTransitionEnabled                types.Bool   `tfsdk:"bss_transition_enabled"`
 	AdvertiseDeviceName                 types.Bool   `tfsdk:"advertise_device_name"`
+	BasicDataRate2GKbps                 types.Int64  `tfsdk:"basic_data_rate_2g_kbps"`
+	BasicDataRate5GKbps                 types.Int64  `tfsdk:"basic_data_rate_5g_kbps"`
+}
+
+// guestAuthMethodNone is reported when a WiFi broadcast has no hotspot (guest portal).
+const guestAuthMethodNone = "none"
+
+// supportedGuestAuthMethods lists the hotspot authentication methods accepted for guest_auth_method.
+// guestAuthMethodNone is only reported, since the API cannot turn a hotspot off.
+var supportedGuestAuthMethods = []string{"voucher", "password", "radius"}
+
+const wifiSecurityTypeOpen = "open"
+
+// wifiPersonalSecurityTypes use a passphrase; wpa3 is SAE only.
+var wifiPersonalSecurityTypes = []string{"wpa2", "wpa3", "wpa2wpa3"}
+
+// wifiEnterpriseSecurityTypes authenticate clients against a RADIUS profile.
+var wifiEnterpriseSecurityTypes = []string{"wpa2-enterprise", "wpa3-enterprise", "wpa2wpa3-enterprise"}
+
+// supportedBasicDataRates2GKbps lists the 2.4 GHz rates accepted as a basic (minimum) data rate.
+var supportedBasicDataRates2GKbps = []int64{1000, 2000, 5500, 6000, 9000, 11000, 12000, 18000, 24000, 36000, 48000, 54000}
+
+// supportedBasicDataRates5GKbps lists the 5 GHz rates accepted as a basic (minimum) data rate.
+var supportedBasicDataRates5GKbps = []int64{6000, 9000, 12000, 18000, 24000, 36000, 48000, 54000}
+
+// wifiBroadcastResourceData is the resource state: the attributes shared with
+// the unifi_wifi_broadcast data source plus the resource-only timeouts block.
+type wifiBroadcastResourceData struct {
+	WifiBroadcastResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *WifiBroadcastResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -93,18 +136,44 @@ func (r *WifiBroadcastResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "The network ID to associate with this WiFi broadcast.",
 				Optional:            true,
 			},
+			"network_reference_type": schema.StringAttribute{
+				MarkdownDescription: "How the WiFi broadcast references its network. Defaults to `network` when `network_id` is set; other reference types returned by the controller are kept as read.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					wifiNetworkReferenceTypePlanModifier{},
+				},
+			},
+			"guest_auth_method": schema.StringAttribute{
+				MarkdownDescription: "How guests authenticate on the hotspot portal (voucher, password, radius). Use `voucher` to admit guests with codes from `unifi_voucher`; `radius` requires `security_configuration.radius_profile_id`. When unset, the hotspot configured on the controller is kept and reported here, or `none` if there is none. The API cannot turn a hotspot off, so disable an existing hotspot in the UniFi UI.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(supportedGuestAuthMethods...),
+				},
+			},
 			"security_configuration": schema.SingleNestedAttribute{
 				MarkdownDescription: "Security configuration for the WiFi broadcast.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						MarkdownDescription: "Security type (open, wpa2, wpa3, wpa2wpa3).",
+						MarkdownDescription: "Security type (open, wpa2, wpa3, wpa2wpa3, wpa2-enterprise, wpa3-enterprise, wpa2wpa3-enterprise). `wpa3` is SAE only. Personal types require `passphrase`; enterprise types require `radius_profile_id` and must not set `passphrase`.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(slices.Concat([]string{wifiSecurityTypeOpen}, wifiPersonalSecurityTypes, wifiEnterpriseSecurityTypes)...),
+						},
 					},
 					"passphrase": schema.StringAttribute{
-						MarkdownDescription: "WiFi passphrase.",
+						MarkdownDescription: "WiFi passphrase. Must be 8 to 63 printable ASCII characters, or a 64 character hexadecimal key.",
 						Optional:            true,
 						Sensitive:           true,
+						Validators: []validator.String{
+							wpaPassphraseValidator{},
+						},
 					},
 					"pmf_mode": schema.StringAttribute{
 						MarkdownDescription: "Protected Management Frames mode (disabled, optional, required).",
@@ -141,7 +210,7 @@ func (r *WifiBroadcastResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						MarkdownDescription: "Filter type (all, include, exclude).",
+						MarkdownDescription: "Filter type (all, include, exclude). `include` and `exclude` require `device_ids` or `device_tag_ids`; `all` takes neither.",
 						Required:            true,
 					},
 					"device_ids": schema.ListAttribute{
@@ -150,12 +219,44 @@ func (r *WifiBroadcastResource) Schema(ctx context.Context, req resource.SchemaR
 						ElementType:         types.StringType,
 					},
 					"device_tag_ids": schema.ListAttribute{
-						MarkdownDescription: "List of device tag IDs.",
+						MarkdownDescription: "List of device tag IDs. Access point groups are represented as device tags.",
 						Optional:            true,
 						ElementType:         types.StringType,
 					},
 				},
 			},
+			"blackout_schedule": schema.ListNestedAttribute{
+				MarkdownDescription: "Days and times during which the WiFi broadcast is turned off. Each entry is passed to the API unchanged, so `type` and `day` take the values the controller uses.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the blackout entry.",
+							Required:            true,
+						},
+						"day": schema.StringAttribute{
+							MarkdownDescription: "The day of the week the entry applies to.",
+							Required:            true,
+						},
+						"time_ranges": schema.ListNestedAttribute{
+							MarkdownDescription: "Time ranges on that day during which the broadcast is off.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start_time": schema.StringAttribute{
+										MarkdownDescription: "Start time (HH:MM, 24-hour).",
+										Required:            true,
+									},
+									"end_time": schema.StringAttribute{
+										MarkdownDescription: "End time (HH:MM, 24-hour).",
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"multicast_to_unicast_conversion_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether multicast to unicast conversion is enabled. Defaults to `false`.",
 				Optional:            true,
@@ -205,10 +306,68 @@ func (r *WifiBroadcastResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Whether to advertise device name.",
 				Optional:            true,
 			},
+			"basic_data_rate_2g_kbps": schema.Int64Attribute{
+				MarkdownDescription: "Minimum (basic) data rate on 2.4 GHz in kbps. Set to `6000` or higher to disable legacy 802.11b rates, or `12000` or higher to also exclude the slowest 802.11g rates. When unset, the rate configured on the controller is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(supportedBasicDataRates2GKbps...),
+				},
+			},
+			"basic_data_rate_5g_kbps": schema.Int64Attribute{
+				MarkdownDescription: "Minimum (basic) data rate on 5 GHz in kbps. The API sets both bands together, so when unset the rate configured on the controller is kept and sent back with the 2.4 GHz rate.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(supportedBasicDataRates5GKbps...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+var _ planmodifier.String = wifiNetworkReferenceTypePlanModifier{}
+
+// wifiNetworkReferenceTypePlanModifier clears a `network` reference type carried over
+// from state once network_id is removed, since no network reference is sent then.
+type wifiNetworkReferenceTypePlanModifier struct{}
+
+func (m wifiNetworkReferenceTypePlanModifier) Description(ctx context.Context) string {
+	return "Clears a network reference type carried over from state when network_id is removed."
+}
+
+func (m wifiNetworkReferenceTypePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m wifiNetworkReferenceTypePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.ValueString() != "network" {
+		return
+	}
+
+	var networkID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("network_id"), &networkID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if networkID.IsNull() {
+		resp.PlanValue = types.StringNull()
+	}
+}
+
 func (r *WifiBroadcastResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -222,18 +381,27 @@ func (r *WifiBroadcastResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *WifiBroadcastResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data WifiBroadcastResourceModel
+	var data wifiBroadcastResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating UniFi WiFi broadcast", map[string]interface{}{
 		"site_id": data.SiteID.ValueString(),
 		"name":    data.Name.ValueString(),
 	})
 
-	createReq := r.buildCreateRequest(ctx, &data, &resp.Diagnostics)
+	createReq := r.buildCreateRequest(ctx, &data.WifiBroadcastResourceModel, &resp.Diagnostics)
+	createReq.HotspotConfiguration = configuredWifiHotspotConfiguration(ctx, req.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -245,12 +413,17 @@ func (r *WifiBroadcastResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	data.ID = types.StringValue(wifiResp.ID)
+	data.NetworkReferenceType = resolveWifiNetworkReferenceType(data.NetworkReferenceType, createReq.Network)
+	if data.GuestAuthMethod.IsUnknown() {
+		data.GuestAuthMethod = mapGuestAuthMethod(wifiResp.HotspotConfiguration)
+	}
+	resolveBasicDataRates(&data.WifiBroadcastResourceModel, wifiResp.BasicDataRateKbpsByFrequencyGHz)
 	tflog.Debug(ctx, "Created UniFi WiFi broadcast", map[string]interface{}{"id": wifiResp.ID})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WifiBroadcastResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data WifiBroadcastResourceModel
+	var data wifiBroadcastResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -261,42 +434,69 @@ func (r *WifiBroadcastResource) Read(ctx context.Context, req resource.ReadReque
 		WifiBroadcastID: data.ID.ValueString(),
 	})
 	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "The WiFi broadcast no longer exists, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WiFi broadcast: %s", err))
 		return
 	}
 
-	r.mapResponseToModel(ctx, wifiResp, &data, &resp.Diagnostics)
+	r.mapResponseToModel(ctx, wifiResp, &data.WifiBroadcastResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WifiBroadcastResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data WifiBroadcastResourceModel
+	var data wifiBroadcastResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := r.buildUpdateRequest(ctx, &data, &resp.Diagnostics)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	updateReq := r.buildUpdateRequest(ctx, &data.WifiBroadcastResourceModel, &resp.Diagnostics)
+	updateReq.HotspotConfiguration = configuredWifiHotspotConfiguration(ctx, req.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.UpdateWifiBroadcast(ctx, updateReq)
+	wifiResp, err := r.client.UpdateWifiBroadcast(ctx, updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update WiFi broadcast: %s", err))
 		return
 	}
 
+	data.NetworkReferenceType = resolveWifiNetworkReferenceType(data.NetworkReferenceType, updateReq.Network)
+	resolveBasicDataRates(&data.WifiBroadcastResourceModel, wifiResp.BasicDataRateKbpsByFrequencyGHz)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *WifiBroadcastResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data WifiBroadcastResourceModel
+	var data wifiBroadcastResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.DeleteWifiBroadcast(ctx, networktypes.DeleteWifiBroadcastRequest{
 		SiteID:          data.SiteID.ValueString(),
 		WifiBroadcastID: data.ID.ValueString(),
@@ -308,7 +508,181 @@ func (r *WifiBroadcastResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *WifiBroadcastResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateSiteScopedID(ctx, req, resp)
+}
+
+func (r *WifiBroadcastResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data wifiBroadcastResourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sec WifiSecurityConfigModel
+	if !data.SecurityConfiguration.IsNull() && !data.SecurityConfiguration.IsUnknown() {
+		resp.Diagnostics.Append(data.SecurityConfiguration.As(ctx, &sec, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.BroadcastingDeviceFilter.IsNull() && !data.BroadcastingDeviceFilter.IsUnknown() {
+		var filter BroadcastingDeviceFilterModel
+		resp.Diagnostics.Append(data.BroadcastingDeviceFilter.As(ctx, &filter, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		validateBroadcastingDeviceFilter(filter, &resp.Diagnostics)
+	}
+
+	if !data.BlackoutSchedule.IsNull() && !data.BlackoutSchedule.IsUnknown() {
+		validateBlackoutSchedule(ctx, data.BlackoutSchedule, &resp.Diagnostics)
+	}
+
+	if !data.GuestAuthMethod.IsUnknown() && data.GuestAuthMethod.ValueString() == "radius" && sec.RadiusProfileID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("guest_auth_method"),
+			"Missing RADIUS Profile",
+			"guest_auth_method = \"radius\" requires security_configuration.radius_profile_id to be set.",
+		)
+	}
+}
+
+// validateBroadcastingDeviceFilter checks the device lists against the filter type: an
+// include or exclude filter needs devices or device tags to apply to, and an all filter
+// takes neither.
+func validateBroadcastingDeviceFilter(filter BroadcastingDeviceFilterModel, diags *diag.Diagnostics) {
+	if filter.Type.IsNull() || filter.Type.IsUnknown() || filter.DeviceIDs.IsUnknown() || filter.DeviceTagIDs.IsUnknown() {
+		return
+	}
+
+	filterPath := path.Root("broadcasting_device_filter")
+	hasDevices := len(filter.DeviceIDs.Elements()) > 0 || len(filter.DeviceTagIDs.Elements()) > 0
+	filterType := filter.Type.ValueString()
+
+	switch {
+	case strings.EqualFold(filterType, "all"):
+		if hasDevices {
+			diags.AddAttributeError(
+				filterPath.AtName("type"),
+				"Unexpected Broadcasting Devices",
+				"device_ids and device_tag_ids must be empty when the filter type is all.",
+			)
+		}
+	case strings.EqualFold(filterType, "include"), strings.EqualFold(filterType, "exclude"):
+		if !hasDevices {
+			diags.AddAttributeError(
+				filterPath.AtName("type"),
+				"Missing Broadcasting Devices",
+				fmt.Sprintf("An %s filter requires device_ids or device_tag_ids.", filterType),
+			)
+		}
+	}
+}
+
+// validateBlackoutSchedule checks that the start_time and end_time of every time range
+// are 24-hour HH:MM values.
+func validateBlackoutSchedule(ctx context.Context, scheduleList types.List, diags *diag.Diagnostics) {
+	for i, dayElem := range scheduleList.Elements() {
+		dayObj, ok := dayElem.(types.Object)
+		if !ok || dayObj.IsNull() || dayObj.IsUnknown() {
+			continue
+		}
+		var day BlackoutScheduleDayModel
+		diags.Append(dayObj.As(ctx, &day, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if diags.HasError() || day.TimeRanges.IsNull() || day.TimeRanges.IsUnknown() {
+			continue
+		}
+
+		dayPath := path.Root("blackout_schedule").AtListIndex(i)
+		for j, rangeElem := range day.TimeRanges.Elements() {
+			rangeObj, ok := rangeElem.(types.Object)
+			if !ok || rangeObj.IsNull() || rangeObj.IsUnknown() {
+				continue
+			}
+			var timeRange BlackoutScheduleTimeRangeModel
+			diags.Append(rangeObj.As(ctx, &timeRange, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+			if diags.HasError() {
+				continue
+			}
+			rangePath := dayPath.AtName("time_ranges").AtListIndex(j)
+			validateScheduleTime(rangePath.AtName("start_time"), timeRange.StartTime, diags)
+			validateScheduleTime(rangePath.AtName("end_time"), timeRange.EndTime, diags)
+		}
+	}
+}
+
+func (r *WifiBroadcastResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		wifiSecurityConfigurationValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = wifiSecurityConfigurationValidator{}
+
+// wifiSecurityConfigurationValidator checks that the passphrase and RADIUS profile match the
+// security type: personal (PSK/SAE) types need a passphrase, enterprise types need a RADIUS
+// profile instead, and open networks take neither.
+type wifiSecurityConfigurationValidator struct{}
+
+func (v wifiSecurityConfigurationValidator) Description(ctx context.Context) string {
+	return "Checks that the passphrase and RADIUS profile set match the security type."
+}
+
+func (v wifiSecurityConfigurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Checks that `passphrase` and `radius_profile_id` set match the security `type`."
+}
+
+func (v wifiSecurityConfigurationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	secPath := path.Root("security_configuration")
+
+	var secObj types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, secPath, &secObj)...)
+	if resp.Diagnostics.HasError() || secObj.IsNull() || secObj.IsUnknown() {
+		return
+	}
+
+	var sec WifiSecurityConfigModel
+	resp.Diagnostics.Append(secObj.As(ctx, &sec, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if resp.Diagnostics.HasError() || sec.Type.IsNull() || sec.Type.IsUnknown() {
+		return
+	}
+
+	securityType := sec.Type.ValueString()
+
+	switch {
+	case securityType == wifiSecurityTypeOpen:
+		if !sec.Passphrase.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				secPath.AtName("passphrase"),
+				"Unexpected Passphrase",
+				"passphrase must not be set for an open WiFi broadcast.",
+			)
+		}
+	case slices.Contains(wifiEnterpriseSecurityTypes, securityType):
+		if !sec.Passphrase.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				secPath.AtName("passphrase"),
+				"Unexpected Passphrase",
+				fmt.Sprintf("passphrase must not be set for %s; clients authenticate through the RADIUS profile.", securityType),
+			)
+		}
+		if sec.RadiusProfileID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				secPath.AtName("radius_profile_id"),
+				"Missing RADIUS Profile",
+				fmt.Sprintf("radius_profile_id is required for %s.", securityType),
+			)
+		}
+	default:
+		if sec.Passphrase.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				secPath.AtName("passphrase"),
+				"Missing Passphrase",
+				fmt.Sprintf("passphrase is required for %s.", securityType),
+			)
+		}
+	}
 }
 
 func (r *WifiBroadcastResource) buildCreateRequest(ctx context.Context, data *WifiBroadcastResourceModel, diags *diag.Diagnostics) networktypes.CreateWifiBroadcastRequest {
@@ -323,12 +697,7 @@ func (r *WifiBroadcastResource) buildCreateRequest(ctx context.Context, data *Wi
 		UapsdEnabled:                        data.UapsdEnabled.ValueBool(),
 	}
 
-	if !data.NetworkID.IsNull() && !data.NetworkID.IsUnknown() {
-		createReq.Network = &networktypes.WifiNetworkReference{
-			Type:      "network",
-			NetworkID: data.NetworkID.ValueString(),
-		}
-	}
+	createReq.Network = buildWifiNetworkReference(data)
 
 	if !data.SecurityConfiguration.IsNull() && !data.SecurityConfiguration.IsUnknown() {
 		createReq.SecurityConfiguration = r.buildSecurityConfiguration(ctx, data.SecurityConfiguration, diags)
@@ -338,6 +707,10 @@ func (r *WifiBroadcastResource) buildCreateRequest(ctx context.Context, data *Wi
 		createReq.BroadcastingDeviceFilter = r.buildBroadcastingDeviceFilter(ctx, data.BroadcastingDeviceFilter, diags)
 	}
 
+	if !data.BlackoutSchedule.IsNull() && !data.BlackoutSchedule.IsUnknown() {
+		createReq.BlackoutScheduleConfiguration = buildBlackoutScheduleConfiguration(ctx, data.BlackoutSchedule, diags)
+	}
+
 	if !data.BroadcastingFrequenciesGHz.IsNull() {
 		var freqs []float64
 		diags.Append(data.BroadcastingFrequenciesGHz.ElementsAs(ctx, &freqs, false)...)
@@ -364,6 +737,7 @@ func (r *WifiBroadcastResource) buildCreateRequest(ctx context.Context, data *Wi
 		adv := data.AdvertiseDeviceName.ValueBool()
 		createReq.AdvertiseDeviceName = &adv
 	}
+	createReq.BasicDataRateKbpsByFrequencyGHz = buildBasicDataRates(data.BasicDataRate2GKbps, data.BasicDataRate5GKbps)
 
 	return createReq
 }
@@ -381,12 +755,7 @@ func (r *WifiBroadcastResource) buildUpdateRequest(ctx context.Context, data *Wi
 		UapsdEnabled:                        data.UapsdEnabled.ValueBool(),
 	}
 
-	if !data.NetworkID.IsNull() && !data.NetworkID.IsUnknown() {
-		updateReq.Network = &networktypes.WifiNetworkReference{
-			Type:      "network",
-			NetworkID: data.NetworkID.ValueString(),
-		}
-	}
+	updateReq.Network = buildWifiNetworkReference(data)
 
 	if !data.SecurityConfiguration.IsNull() && !data.SecurityConfiguration.IsUnknown() {
 		updateReq.SecurityConfiguration = r.buildSecurityConfiguration(ctx, data.SecurityConfiguration, diags)
@@ -396,6 +765,12 @@ func (r *WifiBroadcastResource) buildUpdateRequest(ctx context.Context, data *Wi
 		updateReq.BroadcastingDeviceFilter = r.buildBroadcastingDeviceFilter(ctx, data.BroadcastingDeviceFilter, diags)
 	}
 
+	// An empty configuration clears a schedule that was removed from the config.
+	updateReq.BlackoutScheduleConfiguration = &networktypes.BlackoutScheduleConfiguration{}
+	if !data.BlackoutSchedule.IsNull() && !data.BlackoutSchedule.IsUnknown() {
+		updateReq.BlackoutScheduleConfiguration = buildBlackoutScheduleConfiguration(ctx, data.BlackoutSchedule, diags)
+	}
+
 	if !data.BroadcastingFrequenciesGHz.IsNull() {
 		var freqs []float64
 		diags.Append(data.BroadcastingFrequenciesGHz.ElementsAs(ctx, &freqs, false)...)
@@ -422,10 +797,66 @@ func (r *WifiBroadcastResource) buildUpdateRequest(ctx context.Context, data *Wi
 		adv := data.AdvertiseDeviceName.ValueBool()
 		updateReq.AdvertiseDeviceName = &adv
 	}
+	updateReq.BasicDataRateKbpsByFrequencyGHz = buildBasicDataRates(data.BasicDataRate2GKbps, data.BasicDataRate5GKbps)
 
 	return updateReq
 }
 
+// buildWifiNetworkReference returns the network reference for the request, or nil when
+// neither network_id nor network_reference_type is configured.
+func buildWifiNetworkReference(data *WifiBroadcastResourceModel) *networktypes.WifiNetworkReference {
+	hasID := !data.NetworkID.IsNull() && !data.NetworkID.IsUnknown()
+	hasType := !data.NetworkReferenceType.IsNull() && !data.NetworkReferenceType.IsUnknown()
+	// A `network` reference is meaningless without an ID, e.g. after network_id is removed
+	// and the reference type is carried over from state.
+	if !hasID && (!hasType || data.NetworkReferenceType.ValueString() == "network") {
+		return nil
+	}
+
+	ref := &networktypes.WifiNetworkReference{
+		Type:      "network",
+		NetworkID: data.NetworkID.ValueString(),
+	}
+	if hasType {
+		ref.Type = data.NetworkReferenceType.ValueString()
+	}
+	return ref
+}
+
+// configuredWifiHotspotConfiguration converts the configured guest_auth_method into the
+// hotspot configuration. Nothing is sent when it is unset, so a hotspot set up in the
+// UniFi UI is left alone.
+func configuredWifiHotspotConfiguration(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) *networktypes.WifiHotspotConfiguration {
+	var method types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("guest_auth_method"), &method)...)
+	if method.IsNull() || method.IsUnknown() {
+		return nil
+	}
+	return &networktypes.WifiHotspotConfiguration{
+		Enabled: true,
+		Type:    method.ValueString(),
+	}
+}
+
+// mapGuestAuthMethod returns the guest_auth_method for a hotspot configuration returned by the API.
+func mapGuestAuthMethod(hotspot *networktypes.WifiHotspotConfiguration) types.String {
+	if hotspot == nil || !hotspot.Enabled {
+		return types.StringValue(guestAuthMethodNone)
+	}
+	return types.StringValue(strings.ToLower(hotspot.Type))
+}
+
+// resolveWifiNetworkReferenceType fills in an unknown network_reference_type from the reference that was sent.
+func resolveWifiNetworkReferenceType(planned types.String, ref *networktypes.WifiNetworkReference) types.String {
+	if !planned.IsUnknown() {
+		return planned
+	}
+	if ref == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(ref.Type)
+}
+
 type WifiSecurityConfigModel struct {
 	Type                      types.String `tfsdk:"type"`
 	Passphrase                types.String `tfsdk:"passphrase"`
@@ -508,6 +939,98 @@ func (r *WifiBroadcastResource) buildBroadcastingDeviceFilter(ctx context.Contex
 	return result
 }
 
+type BlackoutScheduleDayModel struct {
+	Type       types.String `tfsdk:"type"`
+	Day        types.String `tfsdk:"day"`
+	TimeRanges types.List   `tfsdk:"time_ranges"`
+}
+
+type BlackoutScheduleTimeRangeModel struct {
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+var blackoutScheduleTimeRangeAttrTypes = map[string]attr.Type{
+	"start_time": types.StringType,
+	"end_time":   types.StringType,
+}
+
+var blackoutScheduleDayAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"day":         types.StringType,
+	"time_ranges": types.ListType{ElemType: types.ObjectType{AttrTypes: blackoutScheduleTimeRangeAttrTypes}},
+}
+
+func buildBlackoutScheduleConfiguration(ctx context.Context, scheduleList types.List, diags *diag.Diagnostics) *networktypes.BlackoutScheduleConfiguration {
+	var days []BlackoutScheduleDayModel
+	diags.Append(scheduleList.ElementsAs(ctx, &days, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := &networktypes.BlackoutScheduleConfiguration{}
+	for _, day := range days {
+		scheduleDay := networktypes.BlackoutScheduleDay{
+			Type: day.Type.ValueString(),
+			Day:  day.Day.ValueString(),
+		}
+		if !day.TimeRanges.IsNull() && !day.TimeRanges.IsUnknown() {
+			var ranges []BlackoutScheduleTimeRangeModel
+			diags.Append(day.TimeRanges.ElementsAs(ctx, &ranges, false)...)
+			for _, tr := range ranges {
+				scheduleDay.TimeRanges = append(scheduleDay.TimeRanges, networktypes.BlackoutScheduleTimeRange{
+					StartTime: tr.StartTime.ValueString(),
+					EndTime:   tr.EndTime.ValueString(),
+				})
+			}
+		}
+		result.Days = append(result.Days, scheduleDay)
+	}
+
+	return result
+}
+
+// mapBlackoutSchedule converts the API schedule to the blackout_schedule list, which is
+// null when the broadcast has no blackout days.
+func mapBlackoutSchedule(schedule *networktypes.BlackoutScheduleConfiguration, diags *diag.Diagnostics) types.List {
+	dayType := types.ObjectType{AttrTypes: blackoutScheduleDayAttrTypes}
+	if schedule == nil || len(schedule.Days) == 0 {
+		return types.ListNull(dayType)
+	}
+
+	rangeType := types.ObjectType{AttrTypes: blackoutScheduleTimeRangeAttrTypes}
+	days := make([]attr.Value, 0, len(schedule.Days))
+	for _, day := range schedule.Days {
+		timeRanges := types.ListNull(rangeType)
+		if len(day.TimeRanges) > 0 {
+			ranges := make([]attr.Value, 0, len(day.TimeRanges))
+			for _, tr := range day.TimeRanges {
+				rangeObj, d := types.ObjectValue(blackoutScheduleTimeRangeAttrTypes, map[string]attr.Value{
+					"start_time": types.StringValue(tr.StartTime),
+					"end_time":   types.StringValue(tr.EndTime),
+				})
+				diags.Append(d...)
+				ranges = append(ranges, rangeObj)
+			}
+			list, d := types.ListValue(rangeType, ranges)
+			diags.Append(d...)
+			timeRanges = list
+		}
+
+		dayObj, d := types.ObjectValue(blackoutScheduleDayAttrTypes, map[string]attr.Value{
+			"type":        types.StringValue(day.Type),
+			"day":         types.StringValue(day.Day),
+			"time_ranges": timeRanges,
+		})
+		diags.Append(d...)
+		days = append(days, dayObj)
+	}
+
+	list, d := types.ListValue(dayType, days)
+	diags.Append(d...)
+	return list
+}
+
 func (r *WifiBroadcastResource) mapResponseToModel(ctx context.Context, resp *networktypes.WifiBroadcast, data *WifiBroadcastResourceModel, diags *diag.Diagnostics) {
 	data.Name = types.StringValue(resp.Name)
 	data.Type = types.StringValue(resp.Type)
@@ -518,9 +1041,14 @@ func (r *WifiBroadcastResource) mapResponseToModel(ctx context.Context, resp *ne
 	data.UapsdEnabled = types.BoolValue(resp.UapsdEnabled)
 
 	if resp.Network != nil {
-		data.NetworkID = types.StringValue(resp.Network.NetworkID)
+		data.NetworkID = stringValueOrNull(resp.Network.NetworkID)
+		data.NetworkReferenceType = stringValueOrNull(resp.Network.Type)
+	} else {
+		data.NetworkReferenceType = types.StringNull()
 	}
 
+	data.GuestAuthMethod = mapGuestAuthMethod(resp.HotspotConfiguration)
+
 	if resp.SecurityConfiguration != nil {
 		secAttrTypes := map[string]attr.Type{
 			"type":                         types.StringType,
@@ -602,6 +1130,8 @@ func (r *WifiBroadcastResource) mapResponseToModel(ctx context.Context, resp *ne
 		data.BroadcastingDeviceFilter = filterObj
 	}
 
+	data.BlackoutSchedule = mapBlackoutSchedule(resp.BlackoutScheduleConfiguration, diags)
+
 	if len(resp.BroadcastingFrequenciesGHz) > 0 {
 		freqs, d := types.ListValueFrom(ctx, types.Float64Type, resp.BroadcastingFrequenciesGHz)
 		diags.Append(d...)
@@ -623,4 +1153,50 @@ func (r *WifiBroadcastResource) mapResponseToModel(ctx context.Context, resp *ne
 	if resp.AdvertiseDeviceName != nil {
 		data.AdvertiseDeviceName = types.BoolValue(*resp.AdvertiseDeviceName)
 	}
+
+	data.BasicDataRate2GKbps, data.BasicDataRate5GKbps = mapBasicDataRates(resp.BasicDataRateKbpsByFrequencyGHz)
+}
+
+// buildBasicDataRates returns the basic data rates to send. The API takes both bands in one
+// object and the state carries the controller's rate for a band that is not configured, so
+// sending both keeps an update of one band from clearing the other.
+func buildBasicDataRates(rate2G, rate5G types.Int64) *networktypes.BasicDataRateKbpsByFrequencyGHz {
+	var rates networktypes.BasicDataRateKbpsByFrequencyGHz
+	if !rate2G.IsNull() && !rate2G.IsUnknown() {
+		rates.TwoPointFour = int(rate2G.ValueInt64())
+	}
+	if !rate5G.IsNull() && !rate5G.IsUnknown() {
+		rates.Five = int(rate5G.ValueInt64())
+	}
+	if rates.TwoPointFour == 0 && rates.Five == 0 {
+		return nil
+	}
+	return &rates
+}
+
+// mapBasicDataRates returns the 2.4 GHz and 5 GHz basic data rates returned by the API, each
+// null when it is not set.
+func mapBasicDataRates(rates *networktypes.BasicDataRateKbpsByFrequencyGHz) (types.Int64, types.Int64) {
+	if rates == nil {
+		return types.Int64Null(), types.Int64Null()
+	}
+	rateValue := func(rate int) types.Int64 {
+		if rate == 0 {
+			return types.Int64Null()
+		}
+		return types.Int64Value(int64(rate))
+	}
+	return rateValue(rates.TwoPointFour), rateValue(rates.Five)
+}
+
+// resolveBasicDataRates fills in basic data rates the plan left unknown from a create or
+// update response.
+func resolveBasicDataRates(data *WifiBroadcastResourceModel, rates *networktypes.BasicDataRateKbpsByFrequencyGHz) {
+	rate2G, rate5G := mapBasicDataRates(rates)
+	if data.BasicDataRate2GKbps.IsUnknown() {
+		data.BasicDataRate2GKbps = rate2G
+	}
+	if data.BasicDataRate5GKbps.IsUnknown() {
+		data.BasicDataRate5GKbps = rate5G
+	}
 }
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 murasame29
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	networktypes "github.com/murasame29/unifi-client-go/services/network/types"
+)
+
+func TestBuildBasicDataRates(t *testing.T) {
+	cases := map[string]struct {
+		rate2G types.Int64
+		rate5G types.Int64
+		want   *networktypes.BasicDataRateKbpsByFrequencyGHz
+	}{
+		"neither": {rate2G: types.Int64Null(), rate5G: types.Int64Null(), want: nil},
+		"unknown": {rate2G: types.Int64Unknown(), rate5G: types.Int64Unknown(), want: nil},
+		"2.4 GHz from config and 5 GHz from state": {
+			rate2G: types.Int64Value(12000),
+			rate5G: types.Int64Value(24000),
+			want:   &networktypes.BasicDataRateKbpsByFrequencyGHz{TwoPointFour: 12000, Five: 24000},
+		},
+		"5 GHz only": {
+			rate2G: types.Int64Null(),
+			rate5G: types.Int64Value(6000),
+			want:   &networktypes.BasicDataRateKbpsByFrequencyGHz{Five: 6000},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := buildBasicDataRates(tc.rate2G, tc.rate5G)
+			switch {
+			case got == nil && tc.want == nil:
+			case got == nil || tc.want == nil || *got != *tc.want:
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMapBasicDataRates(t *testing.T) {
+	rate2G, rate5G := mapBasicDataRates(&networktypes.BasicDataRateKbpsByFrequencyGHz{Five: 12000})
+	if !rate2G.IsNull() {
+		t.Errorf("basic_data_rate_2g_kbps = %s, want null", rate2G)
+	}
+	if rate5G.ValueInt64() != 12000 {
+		t.Errorf("basic_data_rate_5g_kbps = %s, want 12000", rate5G)
+	}
+
+	rate2G, rate5G = mapBasicDataRates(nil)
+	if !rate2G.IsNull() || !rate5G.IsNull() {
+		t.Errorf("got %s and %s for no rates, want null", rate2G, rate5G)
+	}
+}
+
+func TestWifiSecurityConfigurationValidator(t *testing.T) {
+	r := &WifiBroadcastResource{}
+	secType := testAttributeObjectType(t, r, "security_configuration")
+
+	cases := map[string]struct {
+		security   map[string]tftypes.Value
+		wantErrors int
+	}{
+		"open": {
+			security: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "open"),
+			},
+		},
+		"open with a passphrase": {
+			security: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "open"),
+				"passphrase": tftypes.NewValue(tftypes.String, "correct horse"),
+			},
+			wantErrors: 1,
+		},
+		"wpa3 with a passphrase": {
+			security: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "wpa3"),
+				"passphrase": tftypes.NewValue(tftypes.String, "correct horse"),
+			},
+		},
+		"wpa2 without a passphrase": {
+			security: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "wpa2"),
+			},
+			wantErrors: 1,
+		},
+		"enterprise with a RADIUS profile": {
+			security: map[string]tftypes.Value{
+				"type":              tftypes.NewValue(tftypes.String, "wpa2-enterprise"),
+				"radius_profile_id": tftypes.NewValue(tftypes.String, "radius-1"),
+			},
+		},
+		"enterprise with a passphrase and no RADIUS profile": {
+			security: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, "wpa3-enterprise"),
+				"passphrase": tftypes.NewValue(tftypes.String, "correct horse"),
+			},
+			wantErrors: 2,
+		},
+		"unknown type": {
+			security: map[string]tftypes.Value{
+				"type":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"passphrase": tftypes.NewValue(tftypes.String, "correct horse"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := testResourceConfig(t, r, map[string]tftypes.Value{
+				"security_configuration": testObjectValue(secType, tc.security),
+			})
+			resp := validateResourceConfig(t, wifiSecurityConfigurationValidator{}, config)
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
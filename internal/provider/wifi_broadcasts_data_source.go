@@ -25,15 +25,20 @@ type WifiBroadcastsDataSource struct {
 }
 
 type WifiBroadcastsDataSourceModel struct {
-	SiteID     types.String           `tfsdk:"site_id"`
-	Broadcasts []WifiBroadcastSummary `tfsdk:"broadcasts"`
+	SiteID       types.String           `tfsdk:"site_id"`
+	NetworkID    types.String           `tfsdk:"network_id"`
+	Enabled      types.Bool             `tfsdk:"enabled"`
+	TotalCount   types.Int64            `tfsdk:"total_count"`
+	EnabledCount types.Int64            `tfsdk:"enabled_count"`
+	Broadcasts   []WifiBroadcastSummary `tfsdk:"broadcasts"`
 }
 
 type WifiBroadcastSummary struct {
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Type    types.String `tfsdk:"type"`
-	Enabled types.Bool   `tfsdk:"enabled"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	NetworkID types.String `tfsdk:"network_id"`
 }
 
 func (d *WifiBroadcastsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +50,22 @@ func (d *WifiBroadcastsDataSource) Schema(ctx context.Context, req datasource.Sc
 		MarkdownDescription: "Fetches the list of WiFi broadcasts (SSIDs) for a site.",
 		Attributes: map[string]schema.Attribute{
 			"site_id": schema.StringAttribute{Required: true},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Only return WiFi broadcasts attached to this network.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only return WiFi broadcasts with this enabled state.",
+				Optional:            true,
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of WiFi broadcasts returned in `broadcasts`.",
+				Computed:            true,
+			},
+			"enabled_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of enabled WiFi broadcasts returned in `broadcasts`.",
+				Computed:            true,
+			},
 			"broadcasts": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -53,6 +74,10 @@ func (d *WifiBroadcastsDataSource) Schema(ctx context.Context, req datasource.Sc
 						"name":    schema.StringAttribute{Computed: true},
 						"type":    schema.StringAttribute{Computed: true},
 						"enabled": schema.BoolAttribute{Computed: true},
+						"network_id": schema.StringAttribute{
+							MarkdownDescription: "The network the WiFi broadcast is attached to.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -79,23 +104,46 @@ func (d *WifiBroadcastsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	result, err := d.client.ListWifiBroadcasts(ctx, networktypes.ListWifiBroadcastsRequest{
-		SiteID: data.SiteID.ValueString(),
+	result, err := listAllPages(func(page *networktypes.PaginationParams) (*networktypes.PaginatedResponse[networktypes.WifiBroadcast], error) {
+		return d.client.ListWifiBroadcasts(ctx, networktypes.ListWifiBroadcastsRequest{
+			SiteID:     data.SiteID.ValueString(),
+			Pagination: page,
+		})
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WiFi broadcasts: %s", err))
 		return
 	}
 
-	data.Broadcasts = make([]WifiBroadcastSummary, 0, len(result.Data))
-	for _, b := range result.Data {
+	data.Broadcasts = make([]WifiBroadcastSummary, 0, len(result))
+	var enabledCount int64
+	for _, b := range result {
+		var networkID string
+		if b.Network != nil {
+			networkID = b.Network.NetworkID
+		}
+
+		if !data.NetworkID.IsNull() && networkID != data.NetworkID.ValueString() {
+			continue
+		}
+		if !data.Enabled.IsNull() && b.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+
 		data.Broadcasts = append(data.Broadcasts, WifiBroadcastSummary{
-			ID:      types.StringValue(b.ID),
-			Name:    types.StringValue(b.Name),
-			Type:    types.StringValue(b.Type),
-			Enabled: types.BoolValue(b.Enabled),
+			ID:        types.StringValue(b.ID),
+			Name:      types.StringValue(b.Name),
+			Type:      types.StringValue(b.Type),
+			Enabled:   types.BoolValue(b.Enabled),
+			NetworkID: stringValueOrNull(networkID),
 		})
+		if b.Enabled {
+			enabledCount++
+		}
 	}
 
+	data.TotalCount = types.Int64Value(int64(len(data.Broadcasts)))
+	data.EnabledCount = types.Int64Value(enabledCount)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }